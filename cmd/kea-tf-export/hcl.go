@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// attribute : A single HCL attribute, `Name = Value`.
+	attribute struct {
+		Name  string
+		Value any
+	}
+
+	// object : An ordered list of HCL attributes, rendered as a block body or an object constructor.
+	object []attribute
+
+	// block : A labeled HCL block, e.g. `resource "type" "name" { ... }`.
+	block struct {
+		Type   string
+		Labels []string
+		Body   object
+	}
+
+	// exported : A generated resource block and the ID to import it with.
+	exported struct {
+		block
+		importID string
+	}
+
+	// reference : A bare HCL expression that is written without quoting, e.g. a resource address.
+	reference string
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// resourceName : Converts an arbitrary string into a valid Terraform resource name.
+func resourceName(parts ...string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.Join(parts, "_"), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+	return strings.ToLower(name)
+}
+
+// quote : Quotes a string as an HCL string literal, escaping template sequences.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	return strings.ReplaceAll(q, "%{", "%%{")
+}
+
+// write : Writes the block to w, followed by a blank line.
+func (b block) write(w io.Writer) error {
+	header := b.Type
+	for _, l := range b.Labels {
+		header += " " + quote(l)
+	}
+	if _, err := fmt.Fprintf(w, "%s %s\n\n", header, b.Body.render(0)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// render : Renders the object as a multi-line HCL body, with `=` aligned as `terraform fmt` would.
+func (o object) render(indent int) string {
+	pad := strings.Repeat("  ", indent+1)
+	width := 0
+	for _, a := range o {
+		if len(a.Name) > width {
+			width = len(a.Name)
+		}
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("{\n")
+	for _, a := range o {
+		sb.WriteString(fmt.Sprintf("%s%-*s = %s\n", pad, width, a.Name, renderValue(a.Value, indent+1)))
+	}
+	sb.WriteString(strings.Repeat("  ", indent) + "}")
+	return sb.String()
+}
+
+// inline : Renders the object as a single-line HCL object constructor.
+func (o object) inline() string {
+	parts := make([]string, 0, len(o))
+	for _, a := range o {
+		parts = append(parts, fmt.Sprintf("%s = %s", a.Name, renderValue(a.Value, 0)))
+	}
+	return "{ " + strings.Join(parts, ", ") + " }"
+}
+
+// renderValue : Renders a Go value as an HCL expression.
+func renderValue(v any, indent int) string {
+	switch val := v.(type) {
+	case string:
+		return quote(val)
+	case reference:
+		return string(val)
+	case int:
+		return strconv.Itoa(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []string:
+		parts := make([]string, 0, len(val))
+		for _, s := range val {
+			parts = append(parts, quote(s))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case map[string]string:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		o := make(object, 0, len(keys))
+		for _, k := range keys {
+			o = append(o, attribute{Name: quote(k), Value: val[k]})
+		}
+		return o.render(indent)
+	case object:
+		return val.render(indent)
+	case []object:
+		if len(val) == 0 {
+			return "[]"
+		}
+		pad := strings.Repeat("  ", indent+1)
+		sb := strings.Builder{}
+		sb.WriteString("[\n")
+		for _, o := range val {
+			sb.WriteString(pad + o.inline() + ",\n")
+		}
+		sb.WriteString(strings.Repeat("  ", indent) + "]")
+		return sb.String()
+	default:
+		return quote(fmt.Sprintf("%v", val))
+	}
+}
+
+// blocksOf : Returns the resource blocks of the exported objects.
+func blocksOf(e []exported) []block {
+	ret := make([]block, 0, len(e))
+	for _, v := range e {
+		ret = append(ret, v.block)
+	}
+	return ret
+}
+
+// importsFor : Returns an `import` block for every exported object.
+func importsFor(e []exported) []block {
+	ret := make([]block, 0, len(e))
+	for _, v := range e {
+		ret = append(ret, block{
+			Type: "import",
+			Body: object{
+				{Name: "to", Value: reference(v.Labels[0] + "." + v.Labels[1])},
+				{Name: "id", Value: v.importID},
+			},
+		})
+	}
+	return ret
+}
+
+// writeFile : Writes the blocks to the file at path, replacing any existing content.
+func writeFile(path string, blocks []block) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := f.Close(); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	for _, b := range blocks {
+		if err := b.write(w); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
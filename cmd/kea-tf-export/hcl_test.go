@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestResourceName(t *testing.T) {
+	tests := map[string][]string{
+		"subnet_192_168_1_0_24":         {"subnet", "192.168.1.0/24"},
+		"reservation_switch_example_10": {"reservation", "Switch.Example", "10"},
+		"r_10_0_0_0_8":                  {"10.0.0.0/8"},
+	}
+	for want, parts := range tests {
+		if got := resourceName(parts...); got != want {
+			t.Errorf("resourceName(%v) = %q, want %q", parts, got, want)
+		}
+	}
+}
+
+func TestBlockWrite(t *testing.T) {
+	b := block{
+		Type:   "resource",
+		Labels: []string{"kea_remote_subnet4_resource", "subnet_192_168_1_0_24"},
+		Body: object{
+			{Name: "hostname", Value: "kea.example.com"},
+			{Name: "subnet", Value: "192.168.1.0/24"},
+			{Name: "pools", Value: []object{{{Name: "pool", Value: "192.168.1.10-192.168.1.20"}}}},
+			{Name: "user_context", Value: map[string]string{"site": "${AUS}"}},
+		},
+	}
+	want := `resource "kea_remote_subnet4_resource" "subnet_192_168_1_0_24" {
+  hostname     = "kea.example.com"
+  subnet       = "192.168.1.0/24"
+  pools        = [
+    { pool = "192.168.1.10-192.168.1.20" },
+  ]
+  user_context = {
+    "site" = "$${AUS}"
+  }
+}
+
+`
+	buf := &bytes.Buffer{}
+	if err := b.write(buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != want {
+		t.Errorf("block.write() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestSubnet4Parameters(t *testing.T) {
+	lifetime, t1 := 86400, 0.5
+	authoritative := true
+	got := subnet4Parameters(kea.Subnet4Parameters{
+		ValidLifetime: &lifetime,
+		T1Percent:     &t1,
+		Authoritative: &authoritative,
+		Interface:     "eth0",
+	})
+	want := "{ valid_lifetime = 86400, t1_percent = 0.5, authoritative = true, interface = \"eth0\" }"
+	if got.inline() != want {
+		t.Errorf("subnet4Parameters() = %s, want %s", got.inline(), want)
+	}
+}
+
+func TestJSONEncode(t *testing.T) {
+	got := jsonEncode(map[string]any{"site": "${AUS}", "vlan": 230})
+	want := reference(`jsonencode({"site":"$${AUS}","vlan":230})`)
+	if got != want {
+		t.Errorf("jsonEncode() = %s, want %s", got, want)
+	}
+}
+
+func TestOptionData(t *testing.T) {
+	code, space, csv := 3, "vendor-encapsulated-options-space", false
+	got := optionData([]kea.OptionData{
+		{Code: &code, Name: "routers", Data: "192.168.1.1"},
+		{Name: "vendor-option", Data: "0a0b", Space: &space, CSVFormat: &csv, AlwaysSend: true},
+	})
+	want := []string{
+		`{ code = 3, name = "routers", data = "192.168.1.1" }`,
+		`{ name = "vendor-option", data = "0a0b", always_send = true, space = "vendor-encapsulated-options-space", csv_format = false }`,
+	}
+	for i, o := range got {
+		if o.inline() != want[i] {
+			t.Errorf("optionData()[%d] = %s, want %s", i, o.inline(), want[i])
+		}
+	}
+}
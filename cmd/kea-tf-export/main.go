@@ -0,0 +1,400 @@
+// Command kea-tf-export reads the configuration-backend and host reservations of an
+// existing Kea server and writes matching Terraform configuration, including `import`
+// blocks, so that brownfield servers can be brought under management in one step.
+//
+// Credentials are read from the KEA_USERNAME and KEA_PASSWORD environment variables.
+//
+//	kea-tf-export -hostname kea-primary.example.com -out ./kea
+//	terraform -chdir=./kea plan
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// reservationPageSize : Number of reservations fetched per reservation-get-page command.
+const reservationPageSize = 500
+
+// exporter : Converts Kea objects into Terraform blocks for a single Kea server.
+type exporter struct {
+	client   *kea.Client
+	hostname string
+	names    map[string]int
+}
+
+func main() {
+	var (
+		hostname     string
+		out          string
+		reservations bool
+		optionDefs   bool
+		remote       kea.Remote
+	)
+	flag.StringVar(&hostname, "hostname", "", "hostname of the Kea server to export, e.g. kea-primary.example.com")
+	flag.StringVar(&out, "out", ".", "directory to write the generated .tf files to")
+	flag.BoolVar(&reservations, "reservations", true, "export the global host reservations and those of every subnet")
+	flag.BoolVar(&optionDefs, "option-defs", true, "export dhcp4 option definitions")
+	flag.StringVar(&remote.Type, "remote-type", "", "type of the configuration-backend, mysql or postgresql (default postgresql)")
+	flag.StringVar(&remote.Host, "remote-host", "", "host of the configuration-backend database, when several backends are configured")
+	flag.IntVar(&remote.Port, "remote-port", 0, "port of the configuration-backend database, when several backends are configured")
+	flag.Parse()
+
+	if hostname == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	e := &exporter{
+		client:   kea.New(kea.WithRemote(remote)),
+		hostname: hostname,
+		names:    make(map[string]int),
+	}
+	if err := e.run(out, reservations, optionDefs); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run : Exports every supported object from the Kea server into `out`.
+func (e *exporter) run(out string, reservations, optionDefs bool) error {
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	imports := make([]block, 0)
+
+	subnets, subnetIDs, err := e.subnets()
+	if err != nil {
+		return err
+	}
+	imports = append(imports, importsFor(subnets)...)
+	if err := writeFile(filepath.Join(out, "subnets.tf"), blocksOf(subnets)); err != nil {
+		return err
+	}
+
+	if optionDefs {
+		defs, err := e.optionDefs()
+		if err != nil {
+			return err
+		}
+		imports = append(imports, importsFor(defs)...)
+		if err := writeFile(filepath.Join(out, "option_defs.tf"), blocksOf(defs)); err != nil {
+			return err
+		}
+	}
+
+	if reservations {
+		// Global reservations live in subnet ID 0.
+		resvs, err := e.reservations(append([]int{kea.GlobalSubnetID}, subnetIDs...))
+		if err != nil {
+			return err
+		}
+		imports = append(imports, importsFor(resvs)...)
+		if err := writeFile(filepath.Join(out, "reservations.tf"), blocksOf(resvs)); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFile(filepath.Join(out, "imports.tf"), imports); err != nil {
+		return err
+	}
+	log.Printf("exported %d objects from %s to %s", len(imports), e.hostname, out)
+	return nil
+}
+
+// name : Returns a unique resource name for the given resource type.
+func (e *exporter) name(resourceType string, parts ...string) string {
+	name := resourceName(parts...)
+	key := resourceType + "." + name
+	e.names[key]++
+	if n := e.names[key]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+	return name
+}
+
+// subnets : Exports every subnet4 in the configuration-backend.
+func (e *exporter) subnets() ([]exported, []int, error) {
+	list, err := e.client.RemoteSubnet4List(e.hostname)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote-subnet4-list: %w", err)
+	}
+
+	ret := make([]exported, 0, len(list))
+	ids := make([]int, 0, len(list))
+	for _, l := range list {
+		s, err := e.client.RemoteSubnet4GetByID(e.hostname, l.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("remote-subnet4-get-by-id(%d): %w", l.ID, err)
+		}
+		ids = append(ids, s.ID)
+
+		body := object{
+			{Name: "hostname", Value: e.hostname},
+			{Name: "subnet", Value: s.Subnet},
+			{Name: "subnet_id", Value: s.ID},
+			{Name: "pools", Value: func() []object {
+				fr := make([]object, 0, len(s.Pools))
+				for _, p := range s.Pools {
+					fr = append(fr, pool(p))
+				}
+				return fr
+			}()},
+		}
+		if len(s.Relay.IPAddresses) > 0 {
+			body = append(body, attribute{Name: "relay", Value: func() []object {
+				fr := make([]object, 0, len(s.Relay.IPAddresses))
+				for _, ip := range s.Relay.IPAddresses {
+					fr = append(fr, object{{Name: "ip_address", Value: ip}})
+				}
+				return fr
+			}()})
+		}
+		if len(s.OptionData) > 0 {
+			body = append(body, attribute{Name: "option_data", Value: optionData(s.OptionData)})
+		}
+		if len(s.UserContext) > 0 {
+			body = append(body, attribute{Name: "user_context", Value: jsonEncode(s.UserContext)})
+		}
+		if s.NextServer != "" && s.NextServer != "0.0.0.0" {
+			body = append(body, attribute{Name: "next_server", Value: s.NextServer})
+		}
+		if s.ServerHostname != "" {
+			body = append(body, attribute{Name: "server_hostname", Value: s.ServerHostname})
+		}
+		if s.BootFileName != "" {
+			body = append(body, attribute{Name: "boot_file_name", Value: s.BootFileName})
+		}
+		body = append(body, subnet4Parameters(s.Subnet4Parameters)...)
+
+		ret = append(ret, exported{
+			block: block{
+				Type:   "resource",
+				Labels: []string{"kea_remote_subnet4_resource", e.name("kea_remote_subnet4_resource", "subnet", s.Subnet)},
+				Body:   body,
+			},
+			importID: e.hostname + "/" + s.Subnet,
+		})
+	}
+	return ret, ids, nil
+}
+
+// pool : Renders a subnet4 pool, with only the attributes the pool sets.
+func pool(p kea.Pool) object {
+	o := object{{Name: "pool", Value: p.Pool}}
+	if len(p.OptionData) > 0 {
+		o = append(o, attribute{Name: "option_data", Value: optionData(p.OptionData)})
+	}
+	if p.ClientClass != "" {
+		o = append(o, attribute{Name: "client_class", Value: p.ClientClass})
+	}
+	if len(p.RequireClientClasses) > 0 {
+		o = append(o, attribute{Name: "require_client_classes", Value: p.RequireClientClasses})
+	}
+	if len(p.UserContext) > 0 {
+		o = append(o, attribute{Name: "user_context", Value: jsonEncode(p.UserContext)})
+	}
+	return o
+}
+
+// optionDefs : Exports every dhcp4 option definition in the configuration-backend.
+func (e *exporter) optionDefs() ([]exported, error) {
+	defs, err := e.client.RemoteOptionDef4GetAll(e.hostname)
+	if err != nil {
+		return nil, fmt.Errorf("remote-option-def4-get-all: %w", err)
+	}
+
+	ret := make([]exported, 0, len(defs))
+	for _, d := range defs {
+		body := object{
+			{Name: "hostname", Value: e.hostname},
+			{Name: "name", Value: d.Name},
+			{Name: "code", Value: d.Code},
+			{Name: "type", Value: d.Type},
+			{Name: "space", Value: d.Space},
+		}
+		if d.Array {
+			body = append(body, attribute{Name: "array", Value: d.Array})
+		}
+		if d.RecordTypes != "" {
+			body = append(body, attribute{Name: "record_types", Value: d.RecordTypes})
+		}
+		if d.Encapsulate != "" {
+			body = append(body, attribute{Name: "encapsulate", Value: d.Encapsulate})
+		}
+
+		ret = append(ret, exported{
+			block: block{
+				Type:   "resource",
+				Labels: []string{"kea_remote_option_def4_resource", e.name("kea_remote_option_def4_resource", "option_def", d.Space, d.Name)},
+				Body:   body,
+			},
+			importID: fmt.Sprintf("%s/%s/%d", e.hostname, d.Space, d.Code),
+		})
+	}
+	return ret, nil
+}
+
+// reservations : Exports every host reservation in the given subnets.
+func (e *exporter) reservations(subnetIDs []int) ([]exported, error) {
+	ret := make([]exported, 0)
+	for _, id := range subnetIDs {
+		hosts, err := e.client.ReservationList(e.hostname, id, reservationPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("reservation-get-page(%d): %w", id, err)
+		}
+
+		for _, h := range hosts {
+			// The reservation resource requires an address, and is imported by it.
+			if h.IPAddress == "" {
+				log.Printf("skipping reservation %q in subnet %d: reservations without an ip-address are not supported", h.Hostname, h.SubnetID)
+				continue
+			}
+
+			body := object{
+				{Name: "hostname", Value: e.hostname},
+				{Name: "subnet_id", Value: h.SubnetID},
+				{Name: "reservation_hostname", Value: h.Hostname},
+				{Name: "ip_address", Value: h.IPAddress},
+			}
+			for _, a := range []attribute{
+				{Name: "hw_address", Value: h.HwAddress},
+				{Name: "client_id", Value: h.ClientID},
+				{Name: "circuit_id", Value: h.CircuitID},
+				{Name: "duid", Value: h.DuID},
+				{Name: "flex_id", Value: h.FlexID},
+				{Name: "boot_file_name", Value: h.BootFileName},
+				{Name: "next_server", Value: h.NextServer},
+				{Name: "server_hostname", Value: h.ServerHostname},
+			} {
+				if v := a.Value.(string); v != "" && v != "0.0.0.0" {
+					body = append(body, a)
+				}
+			}
+			if len(h.ClientClasses) > 0 {
+				body = append(body, attribute{Name: "client_classes", Value: h.ClientClasses})
+			}
+			if len(h.OptionData) > 0 {
+				body = append(body, attribute{Name: "option_data", Value: optionData(h.OptionData)})
+			}
+			if len(h.UserContext) > 0 {
+				body = append(body, attribute{Name: "user_context", Value: jsonEncode(h.UserContext)})
+			}
+
+			ret = append(ret, exported{
+				block: block{
+					Type:   "resource",
+					Labels: []string{"kea_reservation_resource", e.name("kea_reservation_resource", "reservation", h.Hostname, h.IPAddress)},
+					Body:   body,
+				},
+				importID: e.hostname + "/" + strconv.Itoa(h.SubnetID) + "/" + h.IPAddress,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// optionData : Converts Kea option-data into the provider's option_data list. The option space,
+// always-send, csv-format and never-send are only set when they differ from their defaults.
+func optionData(opts []kea.OptionData) []object {
+	fr := make([]object, 0, len(opts))
+	for _, o := range opts {
+		obj := object{}
+		if o.Code != nil {
+			obj = append(obj, attribute{Name: "code", Value: *o.Code})
+		}
+		if o.Name != "" {
+			obj = append(obj, attribute{Name: "name", Value: o.Name})
+		}
+		obj = append(obj, attribute{Name: "data", Value: o.Data})
+		if o.AlwaysSend {
+			obj = append(obj, attribute{Name: "always_send", Value: true})
+		}
+		if o.Space != nil && *o.Space != "" && *o.Space != kea.DHCP4OptionSpace {
+			obj = append(obj, attribute{Name: "space", Value: *o.Space})
+		}
+		if o.CSVFormat != nil && !*o.CSVFormat {
+			obj = append(obj, attribute{Name: "csv_format", Value: false})
+		}
+		if o.NeverSend != nil && *o.NeverSend {
+			obj = append(obj, attribute{Name: "never_send", Value: true})
+		}
+		fr = append(fr, obj)
+	}
+	return fr
+}
+
+// subnet4Parameters : Converts the subnet and DDNS parameters set in Kea into attributes, inherited parameters are left out.
+func subnet4Parameters(p kea.Subnet4Parameters) object {
+	fr := object{}
+	for _, v := range []struct {
+		name  string
+		value any
+	}{
+		{"valid_lifetime", p.ValidLifetime},
+		{"min_valid_lifetime", p.MinValidLifetime},
+		{"max_valid_lifetime", p.MaxValidLifetime},
+		{"renew_timer", p.RenewTimer},
+		{"rebind_timer", p.RebindTimer},
+		{"calculate_tee_times", p.CalculateTeeTimes},
+		{"t1_percent", p.T1Percent},
+		{"t2_percent", p.T2Percent},
+		{"authoritative", p.Authoritative},
+		{"match_client_id", p.MatchClientID},
+		{"reservations_global", p.ReservationsGlobal},
+		{"reservations_in_subnet", p.ReservationsInSubnet},
+		{"reservations_out_of_pool", p.ReservationsOutOfPool},
+		{"store_extended_info", p.StoreExtendedInfo},
+		{"cache_threshold", p.CacheThreshold},
+		{"cache_max_age", p.CacheMaxAge},
+		{"offer_lifetime", p.OfferLifetime},
+		{"interface", p.Interface},
+		{"ddns_send_updates", p.DDNSSendUpdates},
+		{"ddns_override_client_update", p.DDNSOverrideClientUpdate},
+		{"ddns_override_no_update", p.DDNSOverrideNoUpdate},
+		{"ddns_replace_client_name", p.DDNSReplaceClientName},
+		{"ddns_generated_prefix", p.DDNSGeneratedPrefix},
+		{"ddns_qualifying_suffix", p.DDNSQualifyingSuffix},
+		{"ddns_update_on_renew", p.DDNSUpdateOnRenew},
+		{"ddns_use_conflict_resolution", p.DDNSUseConflictResolution},
+		{"ddns_conflict_resolution_mode", p.DDNSConflictResolutionMode},
+		{"hostname_char_set", p.HostnameCharSet},
+		{"hostname_char_replacement", p.HostnameCharReplacement},
+	} {
+		switch val := v.value.(type) {
+		case *int:
+			if val != nil {
+				fr = append(fr, attribute{Name: v.name, Value: *val})
+			}
+		case *bool:
+			if val != nil {
+				fr = append(fr, attribute{Name: v.name, Value: *val})
+			}
+		case *float64:
+			if val != nil {
+				fr = append(fr, attribute{Name: v.name, Value: *val})
+			}
+		case string:
+			if val != "" {
+				fr = append(fr, attribute{Name: v.name, Value: val})
+			}
+		}
+	}
+	return fr
+}
+
+// jsonEncode : Converts a Kea user-context into a `jsonencode()` expression for the provider's JSON object.
+func jsonEncode(m map[string]any) reference {
+	// The user-context was decoded from JSON, so it always encodes.
+	b, _ := json.Marshal(m)
+	q := strings.ReplaceAll(string(b), "${", "$${")
+	return reference("jsonencode(" + strings.ReplaceAll(q, "%{", "%%{") + ")")
+}
@@ -0,0 +1,4 @@
+data "kea_config_data_source" "example" {
+  hostname = "kea-primary.example.com"
+  service  = "dhcp4"
+}
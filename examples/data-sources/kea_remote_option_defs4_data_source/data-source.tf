@@ -0,0 +1,4 @@
+data "kea_remote_option_defs4_data_source" "example" {
+  hostname = "kea-primary.example.com"
+  space    = "dhcp4"
+}
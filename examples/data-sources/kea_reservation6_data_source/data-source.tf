@@ -0,0 +1,12 @@
+data "kea_reservation6_data_source" "example" {
+  hostname   = "kea-primary.example.com"
+  ip_address = "2001:db8:1::10"
+  subnet_id  = 1
+}
+
+data "kea_reservation6_data_source" "by_duid" {
+  hostname        = "kea-primary.example.com"
+  identifier_type = "duid"
+  identifier      = "00:03:00:01:94:8e:d3:db:d8:c5"
+  subnet_id       = 1
+}
@@ -3,3 +3,10 @@ data "kea_reservation_data_source" "example" {
   ip_or_mac_address = "192.168.230.21"
   subnet_id         = 1921682300
 }
+
+data "kea_reservation_data_source" "by_client_id" {
+  hostname        = "kea-primary.example.com"
+  identifier_type = "client-id"
+  identifier      = "01:94:8e:d3:db:d8:c5"
+  subnet_id       = 1921682300
+}
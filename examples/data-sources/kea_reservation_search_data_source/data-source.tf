@@ -0,0 +1,10 @@
+data "kea_reservation_search_data_source" "by_hostname" {
+  hostname             = "kea-primary.example.com"
+  reservation_hostname = "test.example.com"
+}
+
+data "kea_reservation_search_data_source" "by_mac" {
+  hostname        = "kea-primary.example.com"
+  identifier_type = "hw-address"
+  identifier      = "94:8e:d3:db:d8:c5"
+}
@@ -0,0 +1,4 @@
+data "kea_reservations_data_source" "example" {
+  hostname  = "kea-primary.example.com"
+  subnet_id = 1921682300
+}
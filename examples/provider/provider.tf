@@ -1,4 +1,15 @@
 provider "kea" {
   username = "some-kea-ctrl-user"
   password = "some-kea-ctrl-password"
+
+  # Optional: ask the Kea servers to fetch changes from the configuration-backend
+  # right away, instead of waiting for `config-fetch-wait-time`.
+  pull_after_apply = true
+  pull_servers     = ["kea-primary.example.com", "kea-secondary.example.com"]
+
+  # Optional: the configuration-backend sent as `remote` in every remote-*
+  # command. Resources and data sources can override each attribute.
+  remote_type = "mysql"
+  remote_host = "kea-db.example.com"
+  remote_port = 3306
 }
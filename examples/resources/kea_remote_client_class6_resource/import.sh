@@ -0,0 +1,2 @@
+# Client class6 can be imported by specifying the Kea hostname and class name, `hostname/name`.
+terraform import kea_remote_client_class6_resource.example kea-primary.example.com/voip
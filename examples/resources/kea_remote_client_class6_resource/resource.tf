@@ -0,0 +1,12 @@
+resource "kea_remote_client_class6_resource" "example" {
+  hostname       = "kea-primary.example.com"
+  name           = "voip"
+  test           = "substring(option[1].hex,0,4) == 0x00030001"
+  valid_lifetime = 7200
+  option_data = [
+    {
+      name = "sip-server-addr"
+      data = "2001:db8::5060"
+    }
+  ]
+}
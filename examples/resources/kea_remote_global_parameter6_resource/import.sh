@@ -0,0 +1,2 @@
+# Global parameter6 can be imported by specifying the Kea hostname and parameter name, `hostname/name`.
+terraform import kea_remote_global_parameter6_resource.example kea-primary.example.com/preferred-lifetime
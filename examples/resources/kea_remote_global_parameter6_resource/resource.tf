@@ -0,0 +1,5 @@
+resource "kea_remote_global_parameter6_resource" "example" {
+  hostname = "kea-primary.example.com"
+  name     = "preferred-lifetime"
+  value    = "3000"
+}
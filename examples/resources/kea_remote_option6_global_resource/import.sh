@@ -0,0 +1,2 @@
+# Global option6 can be imported by specifying the Kea hostname, option space and option code, `hostname/space/code`.
+terraform import kea_remote_option6_global_resource.example kea-primary.example.com/dhcp6/23
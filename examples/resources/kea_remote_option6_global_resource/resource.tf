@@ -0,0 +1,5 @@
+resource "kea_remote_option6_global_resource" "example" {
+  hostname = "kea-primary.example.com"
+  code     = 23
+  data     = "2001:db8::53, 2001:db8::54"
+}
@@ -0,0 +1,3 @@
+# Shared-network option6 can be imported by specifying the Kea hostname, shared-network name,
+# option space and option code, `hostname/shared_network_name/space/code`.
+terraform import kea_remote_option6_network_resource.example kea-primary.example.com/lab-network6/dhcp6/24
@@ -0,0 +1,6 @@
+resource "kea_remote_option6_network_resource" "example" {
+  hostname            = "kea-primary.example.com"
+  shared_network_name = "lab-network6"
+  code                = 24
+  data                = "lab.example.com"
+}
@@ -0,0 +1,3 @@
+# Pd-pool option6 can be imported by specifying the Kea hostname, subnet ID, pd-pool prefix, option
+# space and option code, `hostname/subnet_id/prefix/space/code`.
+terraform import kea_remote_option6_pd_pool_resource.example kea-primary.example.com/1/2001:db8:8000::/dhcp6/23
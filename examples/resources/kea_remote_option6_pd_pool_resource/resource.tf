@@ -0,0 +1,7 @@
+resource "kea_remote_option6_pd_pool_resource" "example" {
+  hostname  = "kea-primary.example.com"
+  subnet_id = 1
+  prefix    = "2001:db8:8000::"
+  code      = 23
+  data      = "2001:db8:1::55"
+}
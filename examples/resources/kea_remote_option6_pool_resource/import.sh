@@ -0,0 +1,3 @@
+# Pool option6 can be imported by specifying the Kea hostname, subnet ID, pool range, option space
+# and option code, `hostname/subnet_id/pool/space/code`.
+terraform import kea_remote_option6_pool_resource.example kea-primary.example.com/1/2001:db8:1::10-2001:db8:1::ff/dhcp6/23
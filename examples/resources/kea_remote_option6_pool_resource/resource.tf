@@ -0,0 +1,7 @@
+resource "kea_remote_option6_pool_resource" "example" {
+  hostname  = "kea-primary.example.com"
+  subnet_id = 1
+  pool      = "2001:db8:1::10-2001:db8:1::ff"
+  code      = 23
+  data      = "2001:db8:1::54"
+}
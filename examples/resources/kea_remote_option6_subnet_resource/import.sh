@@ -0,0 +1,3 @@
+# Subnet option6 can be imported by specifying the Kea hostname, subnet ID, option space and
+# option code, `hostname/subnet_id/space/code`.
+terraform import kea_remote_option6_subnet_resource.example kea-primary.example.com/1/dhcp6/23
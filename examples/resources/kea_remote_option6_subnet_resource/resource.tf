@@ -0,0 +1,6 @@
+resource "kea_remote_option6_subnet_resource" "example" {
+  hostname  = "kea-primary.example.com"
+  subnet_id = 1
+  code      = 23
+  data      = "2001:db8:1::53"
+}
@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import kea_remote_option_def4_resource.example 100
\ No newline at end of file
+# Option-def4 can be imported by specifying the Kea hostname, option space and option code, `hostname/space/code`.
+terraform import kea_remote_option_def4_resource.example kea-primary.example.com/dhcp4/222
@@ -0,0 +1,9 @@
+resource "kea_remote_option_def6_resource" "example" {
+  hostname = "kea-primary.example.com"
+  code     = 1234
+  space    = "dhcp6"
+  type     = "record"
+  name     = "vendor-location"
+
+  record_types = "uint16, ipv6-address"
+}
@@ -0,0 +1,2 @@
+# Pool4 can be imported by specifying the Kea hostname, subnet prefix and pool, `hostname/prefix/pool`.
+terraform import kea_remote_pool4_resource.example kea-primary.example.com/192.168.225.0/24/192.168.225.160-192.168.225.190
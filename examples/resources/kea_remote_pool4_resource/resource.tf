@@ -0,0 +1,12 @@
+resource "kea_remote_pool4_resource" "example" {
+  hostname     = "kea-primary.example.com"
+  subnet       = "192.168.225.0/24"
+  pool         = "192.168.225.160-192.168.225.190"
+  client_class = "printers"
+  option_data = [
+    { name = "domain-name-servers", data = "192.168.225.53" },
+  ]
+  user_context = jsonencode({
+    owner = "facilities"
+  })
+}
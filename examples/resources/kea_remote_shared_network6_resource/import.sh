@@ -0,0 +1,2 @@
+# Shared-network6 can be imported by specifying the Kea hostname and shared-network name, `hostname/name`.
+terraform import kea_remote_shared_network6_resource.example kea-primary.example.com/lab-network6
@@ -0,0 +1,17 @@
+resource "kea_remote_shared_network6_resource" "example" {
+  hostname           = "kea-primary.example.com"
+  name               = "lab-network6"
+  interface          = "eth0"
+  rapid_commit       = true
+  preferred_lifetime = 3000
+  valid_lifetime     = 4000
+  option_data = [
+    {
+      name = "dns-servers"
+      data = "2001:db8::53, 2001:db8::54"
+    }
+  ]
+  user_context = jsonencode({
+    site = "AUS"
+  })
+}
@@ -1,2 +1,2 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import kea_remote_subnet4_resource.example 100
\ No newline at end of file
+# Subnet4 can be imported by specifying the Kea hostname and the subnet prefix, `hostname/prefix`.
+terraform import kea_remote_subnet4_resource.example kea-primary.example.com/192.168.225.0/24
@@ -1,18 +1,75 @@
 resource "kea_remote_subnet4_resource" "example" {
   hostname = "kea-primary.example.com"
   subnet   = "192.168.225.0/24"
+  # Optional, computed from `subnet_id_strategy` when omitted.
+  subnet_id = 225
   pools = [
-    { pool = "192.168.225.50-192.168.225.150" }
+    { pool = "192.168.225.50-192.168.225.150" },
+    # Pools carry their own option-data and client classes.
+    {
+      pool         = "192.168.225.200-192.168.225.220"
+      client_class = "voip"
+      option_data  = [{ name = "tftp-server-name", data = "tftp.example.com" }]
+    },
   ]
   relay = [
     { ip_address = "192.168.225.1" }
   ]
   option_data = [
     { code = 3, name = "routers", data = "192.168.225.1" },
-    { code = 15, name = "domain-name", data = "example.com" },
-    { code = 6, name = "domain-name-servers", data = "4.2.2.2, 8.8.8.8", always_send = true },
+    # Either the code or the name identifies the option.
+    { name = "domain-name", data = "example.com" },
+    { code = 6, data = "4.2.2.2, 8.8.8.8", always_send = true },
+    # Raw hexadecimal data, rather than a comma separated list of values.
+    { code = 252, data = "687474703a2f2f7770616400", csv_format = false },
   ]
-  user_context = {
-    "foo" = "bar"
-  }
+  # Option 121, encoded by the provider.
+  classless_static_routes = [
+    { destination = "10.0.0.0/8", gateway = "192.168.225.1" },
+    { destination = "0.0.0.0/0", gateway = "192.168.225.1" },
+  ]
+  # Option 43 sub-options, the provider also writes their option-defs.
+  vendor_options = [
+    { code = 1, name = "wlc-address", type = "ipv4-address", data = "192.168.225.5" },
+    { code = 2, name = "wlc-name", type = "string", data = "wlc-aus" },
+  ]
+  user_context = jsonencode({
+    site = "AUS"
+    vlan = 225
+  })
+
+  # Optional subnet parameters, unset parameters are inherited from the
+  # shared-network or global scope.
+  valid_lifetime         = 86400
+  max_valid_lifetime     = 172800
+  calculate_tee_times    = true
+  t1_percent             = 0.5
+  t2_percent             = 0.875
+  authoritative          = true
+  reservations_global    = false
+  reservations_in_subnet = true
+  cache_threshold        = 0.25
+
+  # Optional DDNS parameters.
+  ddns_send_updates             = true
+  ddns_replace_client_name      = "when-not-present"
+  ddns_qualifying_suffix        = "aus.example.com."
+  ddns_conflict_resolution_mode = "check-with-dhcid"
+  hostname_char_set             = "[^A-Za-z0-9.-]"
+  hostname_char_replacement     = "x"
+}
+
+# Merge mode only manages the configured attributes of a subnet maintained
+# elsewhere. Its other option-data, pools and user-context keys are kept.
+resource "kea_remote_subnet4_resource" "merged" {
+  hostname = "kea-primary.example.com"
+  subnet   = "192.168.226.0/24"
+  merge    = true
+  pools    = []
+  option_data = [
+    { name = "domain-name-servers", data = "192.168.226.53" },
+  ]
+  user_context = jsonencode({
+    owner = "netops"
+  })
 }
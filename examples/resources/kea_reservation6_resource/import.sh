@@ -0,0 +1,4 @@
+# DHCPv6 reservations can be imported by specifying the Kea hostname, subnet ID and either
+# a reserved IPv6 address or `identifier-type=identifier`, one of `duid`, `hw-address` or `flex-id`.
+terraform import kea_reservation6_resource.example kea-primary.example.com/1/2001:db8:1::10
+terraform import kea_reservation6_resource.example kea-primary.example.com/1/duid=00:03:00:01:94:8e:d3:db:d8:c5
@@ -0,0 +1,15 @@
+resource "kea_reservation6_resource" "example" {
+  hostname             = "kea-primary.example.com"
+  reservation_hostname = "cpe.example.com"
+  subnet_id            = 1
+  duid                 = "00:03:00:01:94:8e:d3:db:d8:c5"
+  ip_addresses         = ["2001:db8:1::10"]
+  prefixes             = ["2001:db8:2:abcd::/64"]
+  client_classes       = ["cpe"]
+  option_data = [
+    { code = 23, name = "dns-servers", data = "2001:db8::53" },
+  ]
+  user_context = jsonencode({
+    site = "AUS"
+  })
+}
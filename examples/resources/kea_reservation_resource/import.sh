@@ -1,2 +1,5 @@
-# Order can be imported by specifying the numeric identifier.
-terraform import kea_reservation_resource.example 101
\ No newline at end of file
+# Reservations can be imported by specifying the Kea hostname, subnet ID and either the reserved IP address,
+# the hw-address or `identifier-type=identifier`, one of `hw-address`, `client-id`, `duid`, `circuit-id` or `flex-id`.
+terraform import kea_reservation_resource.example kea-primary.example.com/1921682250/192.168.225.10
+terraform import kea_reservation_resource.example kea-primary.example.com/1921682250/aa:bb:cc:dd:ee:ff
+terraform import kea_reservation_resource.example kea-primary.example.com/1921682250/client-id=01:94:8e:d3:db:d8:c5
@@ -10,3 +10,29 @@ resource "kea_reservation_resource" "example" {
     { code = 6, name = "domain-name-servers", data = "4.4.2.2, 8.8.8.8", always_send = true },
   ]
 }
+
+# Reservations can be identified by exactly one of `hw_address`, `client_id`,
+# `duid`, `circuit_id` or `flex_id`, e.g. for relayed, option-82 based clients.
+resource "kea_reservation_resource" "circuit_id" {
+  hostname             = "kea-primary.example.com"
+  reservation_hostname = "access-switch.example.com"
+  ip_address           = "192.168.230.123"
+  subnet_id            = 1921682300
+  circuit_id           = "'gi0/0/1'"
+  server_hostname      = "tftp.example.com"
+  client_classes       = ["voip"]
+  option_data = [
+    { code = 66, name = "tftp-server-name", data = "746674702e6578616d706c652e636f6d", always_send = true, csv_format = false },
+    { code = 42, name = "ntp-servers", data = "192.168.230.2", always_send = false, never_send = true },
+  ]
+}
+
+# Global reservations omit `subnet_id` (or set it to 0) and follow the client
+# into any subnet that has `reservations-global` enabled.
+resource "kea_reservation_resource" "roaming" {
+  hostname             = "kea-primary.example.com"
+  reservation_hostname = "laptop.example.com"
+  ip_address           = "192.168.230.124"
+  hw_address           = "94:8e:d3:db:d8:c6"
+  operation_target     = "database"
+}
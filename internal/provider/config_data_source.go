@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &configDataSource{}
+	_ datasource.DataSourceWithConfigure = &configDataSource{}
+)
+
+// NewConfigDataSource : Creates a new empty data source client.
+func NewConfigDataSource() datasource.DataSource {
+	return &configDataSource{}
+}
+
+type (
+	// configDataSource defines the data source client.
+	configDataSource struct {
+		client *kea.Client
+	}
+
+	// configDataSourceSchema describes the data source data model.
+	// Maps to the source schema data.
+	configDataSourceSchema struct {
+		Hostname         types.String                  `tfsdk:"hostname"`
+		Service          types.String                  `tfsdk:"service"`
+		Hash             types.String                  `tfsdk:"hash"`
+		ConfigJSON       types.String                  `tfsdk:"config_json"`
+		SubnetIDs        types.List                    `tfsdk:"subnet_ids"`
+		Subnets          []configDataSourceSubnetModel `tfsdk:"subnets"`
+		ValidLifetime    types.Int64                   `tfsdk:"valid_lifetime"`
+		MinValidLifetime types.Int64                   `tfsdk:"min_valid_lifetime"`
+		MaxValidLifetime types.Int64                   `tfsdk:"max_valid_lifetime"`
+		RenewTimer       types.Int64                   `tfsdk:"renew_timer"`
+		RebindTimer      types.Int64                   `tfsdk:"rebind_timer"`
+	}
+
+	// configDataSourceSubnetModel : Represents a single subnet entry in the running configuration.
+	configDataSourceSubnetModel struct {
+		ID     types.Int64  `tfsdk:"id"`
+		Subnet types.String `tfsdk:"subnet"`
+	}
+)
+
+// Metadata : Defines the data source metadata.
+func (d *configDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_data_source"
+}
+
+// Schema : Defines the data source schema.
+func (d *configDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Running configuration data source, as returned by the Kea `config-get` command.",
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Kea service to fetch the running configuration from. One of `dhcp4`, `dhcp6` or `d2`. Defaults to `dhcp4`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{oneOfValidator("dhcp4", "dhcp6", "d2")},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the running configuration, if reported by the Kea server.",
+				Computed:            true,
+			},
+			"config_json": schema.StringAttribute{
+				MarkdownDescription: "Full running configuration of the service, as a normalized JSON string. Use `jsondecode()` to access any field.",
+				Computed:            true,
+			},
+			"subnet_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of every subnet in the running configuration, including subnets in shared-networks.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				MarkdownDescription: "Every subnet in the running configuration, including subnets in shared-networks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":     schema.Int64Attribute{Computed: true},
+						"subnet": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"valid_lifetime":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Global `valid-lifetime`, if set."},
+			"min_valid_lifetime": schema.Int64Attribute{Computed: true, MarkdownDescription: "Global `min-valid-lifetime`, if set."},
+			"max_valid_lifetime": schema.Int64Attribute{Computed: true, MarkdownDescription: "Global `max-valid-lifetime`, if set."},
+			"renew_timer":        schema.Int64Attribute{Computed: true, MarkdownDescription: "Global `renew-timer`, if set."},
+			"rebind_timer":       schema.Int64Attribute{Computed: true, MarkdownDescription: "Global `rebind-timer`, if set."},
+		},
+	}
+}
+
+// Configure : Configures the data source client.
+func (d *configDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read : Reads the data source data into the Terraform state.
+func (d *configDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Define an empty configuration.
+	var config configDataSourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Validate that a `hostname` is specified.
+	if config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"A `hostname` must be specified. DNS name or IP address of the Kea DHCP server.",
+		)
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Service.IsNull() || config.Service.ValueString() == "" {
+		config.Service = types.StringValue("dhcp4")
+	}
+
+	// nolint: contextcheck
+	respData, err := d.client.ConfigGet(config.Hostname.ValueString(), config.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"ConfigGet",
+			fmt.Sprintf("Unable to read the running configuration, got error: %s", err),
+		)
+		return
+	}
+
+	configJSON, err := normalizeJSON(respData.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"ConfigGet",
+			fmt.Sprintf("Unable to normalize the running configuration, got error: %s", err),
+		)
+		return
+	}
+
+	// Marshalling the response data taken from Kea, and write
+	// it into the TF config model.
+	config.Hash = types.StringValue(respData.Hash)
+	config.ConfigJSON = types.StringValue(configJSON)
+	config.ValidLifetime = int64PointerValue(respData.ValidLifetime)
+	config.MinValidLifetime = int64PointerValue(respData.MinValidLifetime)
+	config.MaxValidLifetime = int64PointerValue(respData.MaxValidLifetime)
+	config.RenewTimer = int64PointerValue(respData.RenewTimer)
+	config.RebindTimer = int64PointerValue(respData.RebindTimer)
+
+	subnets := respData.Subnets()
+	config.Subnets = func() []configDataSourceSubnetModel {
+		r := make([]configDataSourceSubnetModel, 0, len(subnets))
+		for _, v := range subnets {
+			r = append(r, configDataSourceSubnetModel{
+				ID:     types.Int64Value(int64(v.ID)),
+				Subnet: types.StringValue(v.Subnet),
+			})
+		}
+		return r
+	}()
+	config.SubnetIDs = func() types.List {
+		r := make([]attr.Value, 0, len(subnets))
+		for _, v := range subnets {
+			r = append(r, types.Int64Value(int64(v.ID)))
+		}
+		retVal, diags := types.ListValue(types.Int64Type, r)
+		resp.Diagnostics.Append(diags...)
+		return retVal
+	}()
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// normalizeJSON : Re-encodes a JSON document with sorted keys and no insignificant
+// whitespace, so that equivalent documents always produce the same string.
+func normalizeJSON(raw []byte) (string, error) {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(buf.Bytes())), nil
+}
+
+// int64PointerValue : Converts an optional Kea integer into a Terraform value,
+// returning null when Kea did not report the parameter.
+func int64PointerValue(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
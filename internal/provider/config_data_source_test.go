@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccConfigDataSourceConfig = fmt.Sprintf(`
+data "kea_config_data_source" "test" {
+  hostname = "%s"
+}`, testAccHostname)
+
+func TestAccConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccConfigDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kea_config_data_source.test", "hostname", testAccHostname),
+					resource.TestCheckResourceAttr("data.kea_config_data_source.test", "service", "dhcp4"),
+					resource.TestCheckResourceAttrSet("data.kea_config_data_source.test", "config_json"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// parseSubnet4ImportID : Parses a `hostname/prefix` import ID, e.g. `kea.example.com/192.168.230.0/24`.
+func parseSubnet4ImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected an import ID of the form `hostname/prefix`, e.g. `kea.example.com/192.168.230.0/24`, got `%s`", id)
+	}
+	if _, _, err := net.ParseCIDR(parts[1]); err != nil {
+		return "", "", fmt.Errorf("invalid prefix `%s` in import ID `%s`, expected CIDR notation, e.g. `192.168.230.0/24`", parts[1], id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseOptionDef4ImportID : Parses a `hostname/space/code` import ID, e.g. `kea.example.com/dhcp4/222`.
+func parseOptionDef4ImportID(id string) (string, string, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", 0, fmt.Errorf("expected an import ID of the form `hostname/space/code`, e.g. `kea.example.com/dhcp4/222`, got `%s`", id)
+	}
+	code, err := strconv.Atoi(parts[2])
+	if err != nil || code < optionCodeMin || code > optionCodeMax {
+		return "", "", 0, fmt.Errorf("invalid option code `%s` in import ID `%s`, expected a number between %d and %d", parts[2], id, optionCodeMin, optionCodeMax)
+	}
+	return parts[0], parts[1], code, nil
+}
+
+// parseReservationImportID : Parses a `hostname/subnet_id/ip-or-mac` or a `hostname/subnet_id/identifier-type=identifier`
+// import ID, e.g. `kea.example.com/1921682300/192.168.230.10`, `kea.example.com/1921682300/aa:bb:cc:dd:ee:ff` or
+// `kea.example.com/1921682300/client-id=01:94:8e:d3:db:d8:c5`. The identifier is everything after the second `/`,
+// so that quoted identifiers may contain one. Returns the identifier type, which is kea.IdentifierIPAddress or
+// kea.IdentifierHwAddress for the first form.
+func parseReservationImportID(id string) (string, int, string, string, error) {
+	format := "expected an import ID of the form `hostname/subnet_id/ip-or-mac` or `hostname/subnet_id/identifier-type=identifier`, " +
+		"e.g. `kea.example.com/1921682300/192.168.230.10` or `kea.example.com/1921682300/client-id=01:94:8e:d3:db:d8:c5`, got `%s`"
+
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", 0, "", "", fmt.Errorf(format, id)
+	}
+	subnetID, err := strconv.Atoi(parts[1])
+	if err != nil || subnetID < 0 || subnetID > kea.MaxSubnetID {
+		return "", 0, "", "", fmt.Errorf("invalid subnet ID `%s` in import ID `%s`, expected a number between 0 and %d", parts[1], id, kea.MaxSubnetID)
+	}
+
+	if identifierType, identifier, ok := strings.Cut(parts[2], "="); ok {
+		if !slices.Contains(kea.IdentifierTypes, identifierType) || identifier == "" {
+			return "", 0, "", "", fmt.Errorf(
+				"invalid identifier `%s` in import ID `%s`, expected one of %s followed by `=` and the identifier",
+				parts[2], id, strings.Join(kea.IdentifierTypes, ", "),
+			)
+		}
+		return parts[0], subnetID, identifierType, identifier, nil
+	}
+	if ip := net.ParseIP(parts[2]); ip != nil && ip.To4() != nil {
+		return parts[0], subnetID, kea.IdentifierIPAddress, parts[2], nil
+	}
+	if _, err := net.ParseMAC(parts[2]); err != nil {
+		return "", 0, "", "", fmt.Errorf("invalid reservation `%s` in import ID `%s`, expected an IPv4 address, a MAC address or `identifier-type=identifier`", parts[2], id)
+	}
+	return parts[0], subnetID, kea.IdentifierHwAddress, parts[2], nil
+}
+
+// parseReservation6ImportID : Parses a `hostname/subnet_id/ip-address` or a
+// `hostname/subnet_id/identifier-type=identifier` import ID, e.g. `kea.example.com/1/2001:db8::10`
+// or `kea.example.com/1/duid=00:03:00:01:94:8e:d3:db:d8:c5`. Returns the identifier type, which
+// is kea.IdentifierIPAddress for the first form.
+func parseReservation6ImportID(id string) (string, int, string, string, error) {
+	format := "expected an import ID of the form `hostname/subnet_id/ip-address` or `hostname/subnet_id/identifier-type=identifier`, " +
+		"e.g. `kea.example.com/1/2001:db8::10` or `kea.example.com/1/duid=00:03:00:01:94:8e:d3:db:d8:c5`, got `%s`"
+
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", 0, "", "", fmt.Errorf(format, id)
+	}
+	subnetID, err := strconv.Atoi(parts[1])
+	if err != nil || subnetID < 0 || subnetID > kea.MaxSubnetID {
+		return "", 0, "", "", fmt.Errorf("invalid subnet ID `%s` in import ID `%s`, expected a number between 0 and %d", parts[1], id, kea.MaxSubnetID)
+	}
+
+	if identifierType, identifier, ok := strings.Cut(parts[2], "="); ok {
+		if !slices.Contains(kea.IdentifierTypes6, identifierType) || identifier == "" {
+			return "", 0, "", "", fmt.Errorf(
+				"invalid identifier `%s` in import ID `%s`, expected one of %s followed by `=` and the identifier",
+				parts[2], id, strings.Join(kea.IdentifierTypes6, ", "),
+			)
+		}
+		return parts[0], subnetID, identifierType, identifier, nil
+	}
+	if ip := net.ParseIP(parts[2]); ip == nil || ip.To4() != nil {
+		return "", 0, "", "", fmt.Errorf(format, id)
+	}
+	return parts[0], subnetID, kea.IdentifierIPAddress, parts[2], nil
+}
+
+// parseOptionDef6ImportID : Parses a `hostname/space/code` import ID, e.g. `kea.example.com/dhcp6/1234`.
+func parseOptionDef6ImportID(id string) (string, string, int, error) {
+	hostname, _, space, code, err := parseOption6ImportID(id, "hostname/space/code", "kea.example.com/dhcp6/1234")
+	return hostname, space, code, err
+}
+
+// parseOption6ImportID : Parses the import ID of a dhcp6 option, made of the hostname, the segments
+// identifying where the option is set, e.g. a subnet ID, then the option space and code. The format
+// and example are shown in errors, e.g. `hostname/subnet_id/space/code`. Returns the scope segments.
+func parseOption6ImportID(id, format, example string) (string, []string, string, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != strings.Count(format, "/")+1 || slices.Contains(parts, "") {
+		return "", nil, "", 0, fmt.Errorf("expected an import ID of the form `%s`, e.g. `%s`, got `%s`", format, example, id)
+	}
+	last := len(parts) - 1
+	code, err := strconv.Atoi(parts[last])
+	if err != nil || code < optionCodeMin || code > option6CodeMax {
+		return "", nil, "", 0, fmt.Errorf("invalid option code `%s` in import ID `%s`, expected a number between %d and %d", parts[last], id, optionCodeMin, option6CodeMax)
+	}
+	return parts[0], parts[1 : last-1], parts[last-1], code, nil
+}
+
+// parseSubnetID : Parses the subnet ID segment of an import ID.
+func parseSubnetID(id, v string) (int, error) {
+	subnetID, err := strconv.Atoi(v)
+	if err != nil || subnetID < 1 || subnetID > kea.MaxSubnetID {
+		return 0, fmt.Errorf("invalid subnet ID `%s` in import ID `%s`, expected a number between 1 and %d", v, id, kea.MaxSubnetID)
+	}
+	return subnetID, nil
+}
+
+// parseNamedImportID : Parses a `hostname/name` import ID of an entry identified by its name,
+// e.g. `kea.example.com/office`. The name is everything after the first `/`.
+func parseNamedImportID(id, example string) (string, string, error) {
+	hostname, name, ok := strings.Cut(id, "/")
+	if !ok || hostname == "" || name == "" {
+		return "", "", fmt.Errorf("expected an import ID of the form `hostname/name`, e.g. `%s`, got `%s`", example, id)
+	}
+	return hostname, name, nil
+}
+
+// parsePool4ImportID : Parses a `hostname/prefix/pool` import ID, e.g.
+// `kea.example.com/192.168.230.0/24/192.168.230.10-192.168.230.200`.
+func parsePool4ImportID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) != 4 || slices.Contains(parts, "") {
+		return "", "", "", fmt.Errorf(
+			"expected an import ID of the form `hostname/prefix/pool`, e.g. `kea.example.com/192.168.230.0/24/192.168.230.10-192.168.230.200`, got `%s`", id,
+		)
+	}
+	prefix := parts[1] + "/" + parts[2]
+	if err := validateIPv4Prefix(prefix); err != nil {
+		return "", "", "", fmt.Errorf("invalid prefix `%s` in import ID `%s`, expected CIDR notation, e.g. `192.168.230.0/24`", prefix, id)
+	}
+	if _, _, err := parsePool(parts[3]); err != nil {
+		return "", "", "", fmt.Errorf("invalid pool `%s` in import ID `%s`: %s", parts[3], id, err)
+	}
+	return parts[0], prefix, parts[3], nil
+}
@@ -0,0 +1,125 @@
+package provider
+
+import "testing"
+
+func TestParseSubnet4ImportID(t *testing.T) {
+	hostname, prefix, err := parseSubnet4ImportID("kea.example.com/192.168.230.0/24")
+	if err != nil || hostname != "kea.example.com" || prefix != "192.168.230.0/24" {
+		t.Fatalf("parseSubnet4ImportID() = %q, %q, %v", hostname, prefix, err)
+	}
+
+	for _, id := range []string{"", "192.168.230.0/24", "kea.example.com/192.168.230.0", "kea.example.com/", "/192.168.230.0/24"} {
+		if _, _, err := parseSubnet4ImportID(id); err == nil {
+			t.Errorf("parseSubnet4ImportID(%q) expected an error", id)
+		}
+	}
+}
+
+func TestParseOptionDef4ImportID(t *testing.T) {
+	hostname, space, code, err := parseOptionDef4ImportID("kea.example.com/dhcp4/222")
+	if err != nil || hostname != "kea.example.com" || space != "dhcp4" || code != 222 {
+		t.Fatalf("parseOptionDef4ImportID() = %q, %q, %d, %v", hostname, space, code, err)
+	}
+
+	for _, id := range []string{"222", "kea.example.com/222", "kea.example.com/dhcp4/abc", "kea.example.com/dhcp4/0", "kea.example.com/dhcp4/255", "kea.example.com/dhcp4/256", "kea.example.com/dhcp4/222/1"} {
+		if _, _, _, err := parseOptionDef4ImportID(id); err == nil {
+			t.Errorf("parseOptionDef4ImportID(%q) expected an error", id)
+		}
+	}
+}
+
+func TestParseReservationImportID(t *testing.T) {
+	for id, want := range map[string][2]string{
+		"kea.example.com/1921682300/192.168.230.10":                  {"ip-address", "192.168.230.10"},
+		"kea.example.com/1921682300/aa:bb:cc:dd:ee:ff":               {"hw-address", "aa:bb:cc:dd:ee:ff"},
+		"kea.example.com/1921682300/client-id=01:94:8e:d3:db:d8:c5":  {"client-id", "01:94:8e:d3:db:d8:c5"},
+		"kea.example.com/1921682300/circuit-id='gi0/0/1'":            {"circuit-id", "'gi0/0/1'"},
+		"kea.example.com/1921682300/duid=00:03:00:01:94:8e:d3:db:d8": {"duid", "00:03:00:01:94:8e:d3:db:d8"},
+	} {
+		hostname, subnetID, identifierType, identifier, err := parseReservationImportID(id)
+		if err != nil || hostname != "kea.example.com" || subnetID != 1921682300 || identifierType != want[0] || identifier != want[1] {
+			t.Errorf("parseReservationImportID(%q) = %q, %d, %q, %q, %v", id, hostname, subnetID, identifierType, identifier, err)
+		}
+	}
+
+	for _, id := range []string{
+		"101", "kea.example.com/192.168.230.10", "kea.example.com/abc/192.168.230.10", "kea.example.com/1921682300/not-an-ip",
+		"kea.example.com/1921682300/2001:db8::10", "kea.example.com/1921682300/client-id=", "kea.example.com/1921682300/serial=01",
+	} {
+		if _, _, _, _, err := parseReservationImportID(id); err == nil {
+			t.Errorf("parseReservationImportID(%q) expected an error", id)
+		}
+	}
+}
+
+func TestParseReservation6ImportID(t *testing.T) {
+	for id, want := range map[string][2]string{
+		"kea.example.com/1/2001:db8::10":                       {"ip-address", "2001:db8::10"},
+		"kea.example.com/1/duid=00:03:00:01:94:8e:d3:db:d8:c5": {"duid", "00:03:00:01:94:8e:d3:db:d8:c5"},
+		"kea.example.com/1/hw-address=94:8e:d3:db:d8:c5":       {"hw-address", "94:8e:d3:db:d8:c5"},
+	} {
+		hostname, subnetID, identifierType, identifier, err := parseReservation6ImportID(id)
+		if err != nil || hostname != "kea.example.com" || subnetID != 1 || identifierType != want[0] || identifier != want[1] {
+			t.Errorf("parseReservation6ImportID(%q) = %q, %d, %q, %q, %v", id, hostname, subnetID, identifierType, identifier, err)
+		}
+	}
+
+	for _, id := range []string{"kea.example.com/1/192.168.230.10", "kea.example.com/1/client-id=01:02", "kea.example.com/1/duid=", "kea.example.com/2001:db8::10"} {
+		if _, _, _, _, err := parseReservation6ImportID(id); err == nil {
+			t.Errorf("parseReservation6ImportID(%q) expected an error", id)
+		}
+	}
+}
+
+func TestParseOption6ImportID(t *testing.T) {
+	hostname, space, code, err := parseOptionDef6ImportID("kea.example.com/dhcp6/1234")
+	if err != nil || hostname != "kea.example.com" || space != "dhcp6" || code != 1234 {
+		t.Fatalf("parseOptionDef6ImportID() = %q, %q, %d, %v", hostname, space, code, err)
+	}
+
+	format := "hostname/subnet_id/pool/space/code"
+	hostname, scope, space, code, err := parseOption6ImportID("kea.example.com/1/2001:db8:1::10-2001:db8:1::ff/dhcp6/23", format, "")
+	if err != nil || hostname != "kea.example.com" || len(scope) != 2 || scope[0] != "1" || scope[1] != "2001:db8:1::10-2001:db8:1::ff" || space != "dhcp6" || code != 23 {
+		t.Fatalf("parseOption6ImportID() = %q, %q, %q, %d, %v", hostname, scope, space, code, err)
+	}
+
+	for _, id := range []string{"kea.example.com/1/dhcp6/23", "kea.example.com/1//dhcp6/23", "kea.example.com/1/2001:db8:1::10-2001:db8:1::ff/dhcp6/65536"} {
+		if _, _, _, _, err := parseOption6ImportID(id, format, ""); err == nil {
+			t.Errorf("parseOption6ImportID(%q) expected an error", id)
+		}
+	}
+	if _, err := parseSubnetID("kea.example.com/0/dhcp6/23", "0"); err == nil {
+		t.Error("parseSubnetID(0) expected an error")
+	}
+}
+
+func TestParseNamedImportID(t *testing.T) {
+	hostname, name, err := parseNamedImportID("kea.example.com/office/floor-2", "")
+	if err != nil || hostname != "kea.example.com" || name != "office/floor-2" {
+		t.Fatalf("parseNamedImportID() = %q, %q, %v", hostname, name, err)
+	}
+
+	for _, id := range []string{"kea.example.com", "kea.example.com/", "/office"} {
+		if _, _, err := parseNamedImportID(id, ""); err == nil {
+			t.Errorf("parseNamedImportID(%q) expected an error", id)
+		}
+	}
+}
+
+func TestParsePool4ImportID(t *testing.T) {
+	for id, want := range map[string]string{
+		"kea.example.com/192.168.230.0/24/192.168.230.10-192.168.230.200": "192.168.230.10-192.168.230.200",
+		"kea.example.com/192.168.230.0/24/192.168.230.64/26":              "192.168.230.64/26",
+	} {
+		hostname, prefix, pool, err := parsePool4ImportID(id)
+		if err != nil || hostname != "kea.example.com" || prefix != "192.168.230.0/24" || pool != want {
+			t.Errorf("parsePool4ImportID(%q) = %q, %q, %q, %v", id, hostname, prefix, pool, err)
+		}
+	}
+
+	for _, id := range []string{"kea.example.com/192.168.230.0/24", "kea.example.com/192.168.230.0/24/", "kea.example.com/192.168.230.0/33/192.168.230.10-192.168.230.200", "kea.example.com/192.168.230.0/24/192.168.230.10"} {
+		if _, _, _, err := parsePool4ImportID(id); err == nil {
+			t.Errorf("parsePool4ImportID(%q) expected an error", id)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	// Ensure the custom types fully satisfy framework interfaces.
+	_ basetypes.StringTypable                    = jsonObjectType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonObjectValue{}
+)
+
+type (
+	// jsonObjectType : A string type holding a JSON object, such as a Kea user-context. Values that decode
+	// to the same object are semantically equal, so key order and whitespace don't show up as a diff.
+	jsonObjectType struct {
+		basetypes.StringType
+	}
+
+	// jsonObjectValue : A value of a jsonObjectType.
+	jsonObjectValue struct {
+		basetypes.StringValue
+	}
+)
+
+// String : Returns a human readable name of the type.
+func (t jsonObjectType) String() string {
+	return "provider.JSONObjectType"
+}
+
+// Equal : Reports whether the other type is a jsonObjectType.
+func (t jsonObjectType) Equal(o attr.Type) bool {
+	_, ok := o.(jsonObjectType)
+	return ok
+}
+
+// ValueType : Returns the value type of the type.
+func (t jsonObjectType) ValueType(_ context.Context) attr.Value {
+	return jsonObjectValue{}
+}
+
+// ValueFromString : Converts a string value into a value of the type.
+func (t jsonObjectType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonObjectValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform : Converts a Terraform value into a value of the type.
+func (t jsonObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	v, diags := t.ValueFromString(ctx, s)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return v, nil
+}
+
+// Type : Returns the type of the value.
+func (v jsonObjectValue) Type(_ context.Context) attr.Type {
+	return jsonObjectType{}
+}
+
+// Equal : Reports whether the other value is exactly equal, see StringSemanticEquals for semantic equality.
+func (v jsonObjectValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonObjectValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals : Reports whether both values decode to the same JSON object.
+func (v jsonObjectValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonObjectValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\nExpected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := decodeJSONObject(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	proposed, err := decodeJSONObject(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(prior, proposed), diags
+}
+
+// userContextAttribute : Returns the user_context attribute of a resource, a JSON object of arbitrary data.
+func userContextAttribute(object string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Arbitrary data to tie to the %s, as a JSON object. "+
+			"e.g. `jsonencode({site = \"AUS\", name = \"Austin, Tx\", vlan = 230})`", object),
+		Optional:   true,
+		CustomType: jsonObjectType{},
+		Validators: []validator.String{jsonObjectValidator()},
+	}
+}
+
+// jsonObjectValidator : Validates that a string attribute holds a JSON object.
+func jsonObjectValidator() validator.String {
+	return stringValidator{summary: "Invalid JSON Object", description: "value must be a JSON object", fn: func(v string) error {
+		_, err := decodeJSONObject(v)
+		return err
+	}}
+}
+
+// decodeJSONObject : Decodes a JSON object, numbers are kept as json.Number so that they compare exactly.
+func decodeJSONObject(s string) (map[string]any, error) {
+	var ret map[string]any
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	if err := d.Decode(&ret); err != nil || ret == nil {
+		return nil, fmt.Errorf("`%s` is not a JSON object, e.g. `jsonencode({site = \"AUS\"})`", s)
+	}
+	if d.More() {
+		return nil, fmt.Errorf("`%s` has data after the JSON object", s)
+	}
+	return ret, nil
+}
+
+// userContextFromSchema : Converts the user_context JSON object into a Kea user-context, nil when null.
+func userContextFromSchema(v jsonObjectValue) map[string]any {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	ret, _ := decodeJSONObject(v.ValueString())
+	return ret
+}
+
+// userContextToSchema : Converts a Kea user-context into the user_context JSON object. An empty
+// user-context keeps a prior null value.
+func userContextToSchema(prior jsonObjectValue, v map[string]any, diags *diag.Diagnostics) jsonObjectValue {
+	if len(v) == 0 && prior.IsNull() {
+		return jsonObjectValue{StringValue: types.StringNull()}
+	}
+	if v == nil {
+		v = map[string]any{}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		diags.AddError("Invalid User Context", fmt.Sprintf("Unable to encode the user-context as JSON: %s", err))
+		return prior
+	}
+	return jsonObjectValue{StringValue: types.StringValue(string(b))}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		prior, new string
+		want       bool
+	}{
+		{prior: `{"site":"AUS","vlan":230}`, new: `{ "vlan": 230, "site": "AUS" }`, want: true},
+		{prior: `{"nested":{"a":[1,2]}}`, new: `{"nested": {"a": [1, 2]}}`, want: true},
+		{prior: `{"vlan":230}`, new: `{"vlan":"230"}`},
+		{prior: `{"vlan":230}`, new: `{"vlan":230.5}`},
+		{prior: `not json`, new: `not json`},
+	} {
+		prior := jsonObjectValue{StringValue: types.StringValue(tc.prior)}
+		got, diags := prior.StringSemanticEquals(context.Background(), jsonObjectValue{StringValue: types.StringValue(tc.new)})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tc.want {
+			t.Errorf("%s semantically equals %s = %v, want %v", tc.prior, tc.new, got, tc.want)
+		}
+	}
+}
+
+func TestDecodeJSONObject(t *testing.T) {
+	for _, v := range []string{`{}`, `{"site":"AUS"}`} {
+		if _, err := decodeJSONObject(v); err != nil {
+			t.Errorf("decodeJSONObject(%s) = %v, want no error", v, err)
+		}
+	}
+	for _, v := range []string{``, `null`, `[]`, `"AUS"`, `{"site":"AUS"} {}`} {
+		if _, err := decodeJSONObject(v); err == nil {
+			t.Errorf("decodeJSONObject(%s) succeeded, want an error", v)
+		}
+	}
+}
+
+func TestUserContextToSchema(t *testing.T) {
+	null := jsonObjectValue{StringValue: types.StringNull()}
+	empty := jsonObjectValue{StringValue: types.StringValue("{}")}
+	var diags diag.Diagnostics
+
+	if got := userContextToSchema(null, nil, &diags); !got.IsNull() {
+		t.Errorf("empty user-context with a null prior = %s, want null", got)
+	}
+	if got := userContextToSchema(empty, nil, &diags); got.ValueString() != "{}" {
+		t.Errorf("empty user-context with an empty prior = %s, want {}", got)
+	}
+	got := userContextToSchema(null, map[string]any{"vlan": 230, "site": "AUS"}, &diags)
+	if got.ValueString() != `{"site":"AUS","vlan":230}` {
+		t.Errorf("userContextToSchema() = %s, want {\"site\":\"AUS\",\"vlan\":230}", got)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	if ctx := userContextFromSchema(got); ctx["site"] != "AUS" || ctx["vlan"] == nil {
+		t.Errorf("userContextFromSchema() = %v, want site and vlan", ctx)
+	}
+	if ctx := userContextFromSchema(null); ctx != nil {
+		t.Errorf("userContextFromSchema(null) = %v, want nil", ctx)
+	}
+}
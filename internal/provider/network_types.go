@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// networkKind : The kind of value held by a networkStringType, which decides how it is normalized.
+type networkKind string
+
+const (
+	networkKindIPAddress  networkKind = "IPAddress"
+	networkKindPrefix     networkKind = "Prefix"
+	networkKindMACAddress networkKind = "MACAddress"
+	networkKindPool       networkKind = "Pool"
+	networkKindIdentifier networkKind = "HexIdentifier"
+)
+
+var (
+	// Ensure the custom types fully satisfy framework interfaces.
+	_ basetypes.StringTypable                    = networkStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = networkStringValue{}
+
+	// ipAddressType : An IPv4 or IPv6 address, e.g. `2001:db8::10` equals `2001:0db8:0:0:0:0:0:10`.
+	ipAddressType = networkStringType{kind: networkKindIPAddress}
+	// prefixType : An IPv4 or IPv6 prefix, e.g. `2001:db8:1::/48` equals `2001:0db8:0001::/48`.
+	prefixType = networkStringType{kind: networkKindPrefix}
+	// macAddressType : A MAC/hardware address, e.g. `94:8e:d3:db:d8:c5` equals `94-8E-D3-DB-D8-C5`.
+	macAddressType = networkStringType{kind: networkKindMACAddress}
+	// poolType : A Kea address pool, e.g. `192.168.230.10-192.168.230.20` equals `192.168.230.10 - 192.168.230.20`,
+	// and `192.168.230.64/26` equals `192.168.230.64-192.168.230.127`. IPv6 pools are normalized the same way.
+	poolType = networkStringType{kind: networkKindPool}
+	// hexIdentifierType : A client identifier such as a DUID, client-id, circuit-id or flex-id, compared by its
+	// octets. Kea returns them as plain uppercase hexadecimal, so `01:94:8e:d3` equals `01948ED3`, and the
+	// quoted string `'gi0/0/1'` equals `6769302F302F31`.
+	hexIdentifierType = networkStringType{kind: networkKindIdentifier}
+)
+
+type (
+	// networkStringType : A string type for network values that Kea normalizes, such as addresses, prefixes,
+	// MAC addresses and pools. Values that only differ in their notation are semantically equal, so that
+	// Kea's normalized form read back after apply does not show up as a diff.
+	networkStringType struct {
+		basetypes.StringType
+		kind networkKind
+	}
+
+	// networkStringValue : A value of a networkStringType.
+	networkStringValue struct {
+		basetypes.StringValue
+		kind networkKind
+	}
+)
+
+// String : Returns a human readable name of the type.
+func (t networkStringType) String() string {
+	return "provider." + string(t.kind) + "Type"
+}
+
+// Equal : Reports whether the other type is the same kind of network type.
+func (t networkStringType) Equal(o attr.Type) bool {
+	other, ok := o.(networkStringType)
+	return ok && other.kind == t.kind
+}
+
+// ValueType : Returns the value type of the type.
+func (t networkStringType) ValueType(_ context.Context) attr.Value {
+	return networkStringValue{kind: t.kind}
+}
+
+// ValueFromString : Converts a string value into a value of the type.
+func (t networkStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return networkStringValue{StringValue: in, kind: t.kind}, nil
+}
+
+// ValueFromTerraform : Converts a Terraform value into a value of the type.
+func (t networkStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	v, diags := t.ValueFromString(ctx, s)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return v, nil
+}
+
+// value : Returns a known value of the type.
+func (t networkStringType) value(s string) networkStringValue {
+	return networkStringValue{StringValue: types.StringValue(s), kind: t.kind}
+}
+
+// valueFrom : Converts a string value, e.g. read from a prior schema version, into a value of the type.
+func (t networkStringType) valueFrom(v types.String) networkStringValue {
+	return networkStringValue{StringValue: v, kind: t.kind}
+}
+
+// valueOrNull : Returns a value of the type, or null when Kea reports the field as empty.
+func (t networkStringType) valueOrNull(s string) networkStringValue {
+	if s == "" {
+		return networkStringValue{StringValue: types.StringNull(), kind: t.kind}
+	}
+	return t.value(s)
+}
+
+// listValue : Converts a Kea string list into a Terraform list of the type, like stringListValue.
+func (t networkStringType) listValue(prior types.List, v []string, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, 0, len(v))
+	for _, s := range v {
+		elems = append(elems, t.value(s))
+	}
+	return listValueOrPrior(prior, t, elems, diags)
+}
+
+// Type : Returns the type of the value.
+func (v networkStringValue) Type(_ context.Context) attr.Type {
+	return networkStringType{kind: v.kind}
+}
+
+// Equal : Reports whether the other value is exactly equal, see StringSemanticEquals for semantic equality.
+func (v networkStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(networkStringValue)
+	return ok && other.kind == v.kind && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals : Reports whether both values normalize to the same network value.
+func (v networkStringValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(networkStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\nExpected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+	return normalizeNetworkValue(v.kind, v.ValueString()) == normalizeNetworkValue(newValue.kind, newValue.ValueString()), diags
+}
+
+// normalizeNetworkValue : Returns the canonical notation of a network value, or the value as is when it can't be parsed.
+func normalizeNetworkValue(kind networkKind, s string) string {
+	s = strings.TrimSpace(s)
+	switch kind {
+	case networkKindIPAddress:
+		if ip := net.ParseIP(s); ip != nil {
+			return ip.String()
+		}
+	case networkKindPrefix:
+		if ip, network, err := net.ParseCIDR(s); err == nil {
+			ones, _ := network.Mask.Size()
+			return ip.String() + "/" + strconv.Itoa(ones)
+		}
+	case networkKindMACAddress:
+		if mac, err := net.ParseMAC(s); err == nil {
+			return mac.String()
+		}
+	case networkKindPool:
+		if start, end, err := parsePool(s); err == nil {
+			return start.String() + "-" + end.String()
+		}
+		if start, end, err := parsePool6(s); err == nil {
+			return start.String() + "-" + end.String()
+		}
+	case networkKindIdentifier:
+		if len(s) >= 2 && strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") {
+			return hex.EncodeToString([]byte(s[1 : len(s)-1]))
+		}
+		if validateHexIdentifier(s) == nil {
+			return strings.ToLower(strings.ReplaceAll(s, ":", ""))
+		}
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNetworkStringSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		typ        networkStringType
+		prior, new string
+		want       bool
+	}{
+		{typ: ipAddressType, prior: "2001:0db8:0:0:0:0:0:10", new: "2001:db8::10", want: true},
+		{typ: ipAddressType, prior: "192.168.230.10", new: "192.168.230.11"},
+		{typ: prefixType, prior: "2001:0db8:0001::/48", new: "2001:db8:1::/48", want: true},
+		{typ: prefixType, prior: "192.168.230.0/24", new: "192.168.230.0/25"},
+		{typ: macAddressType, prior: "94-8E-D3-DB-D8-C5", new: "94:8e:d3:db:d8:c5", want: true},
+		{typ: macAddressType, prior: "94:8e:d3:db:d8:c5", new: "94:8e:d3:db:d8:c6"},
+		{typ: poolType, prior: "192.168.230.10 - 192.168.230.20", new: "192.168.230.10-192.168.230.20", want: true},
+		{typ: poolType, prior: "192.168.230.64/26", new: "192.168.230.64-192.168.230.127", want: true},
+		{typ: poolType, prior: "192.168.230.64/26", new: "192.168.230.64-192.168.230.128"},
+		{typ: poolType, prior: "not a pool", new: "not a pool", want: true},
+		{typ: hexIdentifierType, prior: "01:94:8e:d3:db:d8:c5", new: "01948ED3DBD8C5", want: true},
+		{typ: hexIdentifierType, prior: "'gi0/0/1'", new: "6769302F302F31", want: true},
+		{typ: hexIdentifierType, prior: "'gi0/0/1'", new: "6769302F302F32"},
+		{typ: hexIdentifierType, prior: "00:03:00:01", new: "00030002"},
+	} {
+		got, diags := tc.typ.value(tc.prior).StringSemanticEquals(context.Background(), tc.typ.value(tc.new))
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", tc.typ, diags)
+		}
+		if got != tc.want {
+			t.Errorf("%s: %q semantically equals %q = %v, want %v", tc.typ, tc.prior, tc.new, got, tc.want)
+		}
+	}
+}
+
+func TestNetworkStringStateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewReservation6Resource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	var diags diag.Diagnostics
+	addrs := ipAddressType.listValue(types.ListNull(ipAddressType), []string{"2001:db8:1::10"}, &diags)
+	diags.Append(state.Set(ctx, &reservation6ResourceSchema{
+		Hostname:      types.StringValue("kea.example.com"),
+		SubnetID:      types.Int64Value(1),
+		HwAddress:     macAddressType.value("94:8e:d3:db:d8:c5"),
+		IPAddresses:   addrs,
+		Prefixes:      types.ListNull(prefixType),
+		ClientClasses: types.ListNull(types.StringType),
+		UserContext:   jsonObjectValue{StringValue: types.StringNull()},
+	})...)
+	if diags.HasError() {
+		t.Fatalf("State.Set() diagnostics: %v", diags)
+	}
+
+	var got reservation6ResourceSchema
+	if diags := state.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("State.Get() diagnostics: %v", diags)
+	}
+	if got.HwAddress.Type(ctx) != macAddressType || got.HwAddress.ValueString() != "94:8e:d3:db:d8:c5" {
+		t.Errorf("hw_address = %#v, want a %s value", got.HwAddress, macAddressType)
+	}
+	if addrs := stringListElements(got.IPAddresses); len(addrs) != 1 || addrs[0] != "2001:db8:1::10" {
+		t.Errorf("ip_addresses = %v, want [2001:db8:1::10]", addrs)
+	}
+}
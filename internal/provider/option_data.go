@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// optionDataResourceModel : Represents a single option-data entry in Kea, shared by subnets and reservations.
+type optionDataResourceModel struct {
+	Code       types.Int64  `tfsdk:"code"`
+	Data       types.String `tfsdk:"data"`
+	Name       types.String `tfsdk:"name"`
+	AlwaysSend types.Bool   `tfsdk:"always_send"`
+	Space      types.String `tfsdk:"space"`
+	CSVFormat  types.Bool   `tfsdk:"csv_format"`
+	NeverSend  types.Bool   `tfsdk:"never_send"`
+}
+
+// optionDataAttribute : Returns the option_data schema, with options in the given default space.
+// Either `code` or `name` identifies the option, Kea reports the other one.
+func optionDataAttribute(space string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "List of option-data to configure. Each option is identified by its `code`, its `name`, or both. " +
+			"e.g. `[{name = \"domain-name-servers\", data = \"8.8.8.8, 4.2.2.2\"}]`",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"code": schema.Int64Attribute{
+					MarkdownDescription: "Code of the option. Computed from `name` when omitted, using the standard options or the option-defs in Kea.",
+					Optional:            true,
+					Computed:            true,
+					Validators:          []validator.Int64{optionCodeValidator(space)},
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the option. Computed from `code` when omitted, using the standard options or the option-defs in Kea.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"data": schema.StringAttribute{
+					MarkdownDescription: "Value of the option, validated against the option's type when its definition is known.",
+					Required:            true,
+				},
+				"always_send": schema.BoolAttribute{
+					MarkdownDescription: "Send the option even when the client does not request it. Defaults to `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"space": schema.StringAttribute{
+					MarkdownDescription: fmt.Sprintf("Option space of the option. Defaults to `%s`.", space),
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(space),
+				},
+				"csv_format": schema.BoolAttribute{
+					MarkdownDescription: "Whether `data` is a comma separated list of values, or raw hexadecimal when `false`. Defaults to `true`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+				"never_send": schema.BoolAttribute{
+					MarkdownDescription: "Never send the option, even when the client requests it (Kea 2.2+). Defaults to `false`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// optionDataFromSchema : Converts the option_data model into Kea option-data.
+func optionDataFromSchema(opts []optionDataResourceModel) []kea.OptionData {
+	fr := make([]kea.OptionData, 0)
+	for _, o := range opts {
+		od := kea.OptionData{
+			Name:       o.Name.ValueString(),
+			Data:       o.Data.ValueString(),
+			AlwaysSend: o.AlwaysSend.ValueBool(),
+		}
+		if !o.Code.IsNull() && !o.Code.IsUnknown() {
+			code := int(o.Code.ValueInt64())
+			od.Code = &code
+		}
+		if space := o.Space.ValueString(); space != "" {
+			od.Space = &space
+		}
+		// Only send the non-default values, so that servers predating never-send accept the option.
+		if !o.CSVFormat.IsNull() && !o.CSVFormat.IsUnknown() && !o.CSVFormat.ValueBool() {
+			od.CSVFormat = o.CSVFormat.ValueBoolPointer()
+		}
+		if o.NeverSend.ValueBool() {
+			od.NeverSend = o.NeverSend.ValueBoolPointer()
+		}
+		fr = append(fr, od)
+	}
+	return fr
+}
+
+// optionDataKeys : Returns the identity of the known option_data entries, defaulting a null space to space.
+// Entries without a known code are identified by their name.
+func optionDataKeys(opts []optionDataResourceModel, space string) []optionKey {
+	keys := make([]optionKey, 0, len(opts))
+	for _, o := range opts {
+		if o.Code.IsUnknown() || o.Space.IsUnknown() {
+			continue
+		}
+		k := optionKey{space: space}
+		switch {
+		case !o.Code.IsNull():
+			k.code = o.Code.ValueInt64()
+		case !o.Name.IsNull() && !o.Name.IsUnknown():
+			k.name = o.Name.ValueString()
+		default:
+			continue
+		}
+		if !o.Space.IsNull() {
+			k.space = o.Space.ValueString()
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// validateOptionData : Validates that every option_data entry is identified by a code or a name, and that
+// no option is configured more than once.
+func validateOptionData(attribute path.Path, opts []optionDataResourceModel, space string, diags *diag.Diagnostics) {
+	for _, o := range opts {
+		if o.Code.IsNull() && o.Name.IsNull() {
+			diags.AddAttributeError(
+				attribute,
+				"Missing Option Identity",
+				fmt.Sprintf("Option data `%s` must set `code`, `name`, or both.", o.Data.ValueString()),
+			)
+		}
+	}
+	validateUniqueOptions(attribute, optionDataKeys(opts, space), diags)
+}
+
+// optionDataResolveUnknown : Nulls the code and name that were left for Kea to compute, so that the applied
+// state is known. The next read fills them in from Kea.
+func optionDataResolveUnknown(opts []optionDataResourceModel) {
+	for i := range opts {
+		if opts[i].Code.IsUnknown() {
+			opts[i].Code = types.Int64Null()
+		}
+		if opts[i].Name.IsUnknown() {
+			opts[i].Name = types.StringNull()
+		}
+	}
+}
+
+// optionDataToSchema : Converts Kea option-data into the option_data model, filling in Kea's defaults
+// for the fields it does not report. No option-data keeps a prior null list.
+func optionDataToSchema(prior []optionDataResourceModel, opts []kea.OptionData, space string) []optionDataResourceModel {
+	if len(opts) == 0 && prior == nil {
+		return nil
+	}
+
+	ret := make([]optionDataResourceModel, 0, len(opts))
+	for _, v := range opts {
+		m := optionDataResourceModel{
+			Code:       types.Int64Null(),
+			Data:       types.StringValue(v.Data),
+			Name:       types.StringNull(),
+			AlwaysSend: types.BoolValue(v.AlwaysSend),
+			Space:      types.StringValue(space),
+			CSVFormat:  types.BoolValue(true),
+			NeverSend:  types.BoolValue(false),
+		}
+		if v.Code != nil {
+			m.Code = types.Int64Value(int64(*v.Code))
+		}
+		if v.Name != "" {
+			m.Name = types.StringValue(v.Name)
+		}
+		if v.Space != nil && *v.Space != "" {
+			m.Space = types.StringValue(*v.Space)
+		}
+		if v.CSVFormat != nil {
+			m.CSVFormat = types.BoolValue(*v.CSVFormat)
+		}
+		if v.NeverSend != nil {
+			m.NeverSend = types.BoolValue(*v.NeverSend)
+		}
+		ret = append(ret, m)
+	}
+	return ret
+}
+
+// optionDefLookup : Finds the definition of an option in a space by its code or, when code is 0, by its name.
+type optionDefLookup func(space string, code int, name string) *kea.RemoteOptionDef4
+
+// standardOptionDef4 : Looks up the standard DHCPv4 options, which need no option-def.
+func standardOptionDef4(space string, code int, name string) *kea.RemoteOptionDef4 {
+	if space != kea.DHCP4OptionSpace {
+		return nil
+	}
+	if code != 0 {
+		return kea.StandardOptionDef4(code)
+	}
+	return kea.StandardOptionDef4ByName(name)
+}
+
+// optionDef4Lookup : Looks up the standard DHCPv4 options, then the option definitions in the configuration-backend
+// of hostname. Options that can't be looked up in Kea are left unresolved.
+func optionDef4Lookup(client *kea.Client, hostname string) optionDefLookup {
+	var defs []kea.RemoteOptionDef4
+	loaded := false
+	return func(space string, code int, name string) *kea.RemoteOptionDef4 {
+		if def := standardOptionDef4(space, code, name); def != nil || client == nil || hostname == "" {
+			return def
+		}
+		if code != 0 {
+			// nolint: contextcheck
+			def, err := client.RemoteOptionDef4Get(hostname, space, code)
+			if err != nil {
+				return nil
+			}
+			return def
+		}
+		if !loaded {
+			// nolint: contextcheck
+			defs, _ = client.RemoteOptionDef4GetAll(hostname)
+			loaded = true
+		}
+		for i := range defs {
+			if defs[i].Space == space && defs[i].Name == name {
+				return &defs[i]
+			}
+		}
+		return nil
+	}
+}
+
+// optionDataResolve : Fills in the code or name of the option_data entries left for Kea to compute, and
+// validates that the code and name agree and that data suits the option's type. Options without a known
+// definition are left as they are.
+func optionDataResolve(attribute path.Path, opts []optionDataResourceModel, space string, lookup optionDefLookup, diags *diag.Diagnostics) []optionDataResourceModel {
+	ret := make([]optionDataResourceModel, 0, len(opts))
+	for _, o := range opts {
+		ret = append(ret, o)
+		if (o.Code.IsUnknown() && o.Name.IsUnknown()) || o.Space.IsUnknown() {
+			continue
+		}
+
+		sp := space
+		if !o.Space.IsNull() {
+			sp = o.Space.ValueString()
+		}
+		code, name := 0, ""
+		if !o.Code.IsNull() && !o.Code.IsUnknown() {
+			code = int(o.Code.ValueInt64())
+		}
+		if !o.Name.IsNull() && !o.Name.IsUnknown() {
+			name = o.Name.ValueString()
+		}
+		if code == 0 && name == "" {
+			continue
+		}
+
+		def := lookup(sp, code, name)
+		if def == nil {
+			continue
+		}
+		if code != 0 && name != "" && def.Name != name {
+			diags.AddAttributeError(
+				attribute,
+				"Mismatched Option Name",
+				fmt.Sprintf("Option code %d in space `%s` is `%s`, not `%s`.", code, sp, def.Name, name),
+			)
+			continue
+		}
+		if o.Code.IsUnknown() {
+			ret[len(ret)-1].Code = types.Int64Value(int64(def.Code))
+		}
+		if o.Name.IsUnknown() {
+			ret[len(ret)-1].Name = types.StringValue(def.Name)
+		}
+
+		if o.Data.IsUnknown() || o.Data.IsNull() || o.CSVFormat.IsUnknown() {
+			continue
+		}
+		csv := o.CSVFormat.IsNull() || o.CSVFormat.ValueBool()
+		if err := def.ValidateData(o.Data.ValueString(), csv); err != nil {
+			diags.AddAttributeError(attribute, "Invalid Option Data", err.Error())
+		}
+	}
+	return ret
+}
+
+// planOptionData4 : Resolves the planned option_data against the DHCPv4 option definitions, see optionDataResolve.
+func planOptionData4(ctx context.Context, client *kea.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var hostname types.String
+	var set types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hostname"), &hostname)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("option_data"), &set)...)
+	if resp.Diagnostics.HasError() || set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	var opts []optionDataResourceModel
+	resp.Diagnostics.Append(set.ElementsAs(ctx, &opts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	host := ""
+	if !hostname.IsUnknown() {
+		host = hostname.ValueString()
+	}
+	opts = optionDataResolve(path.Root("option_data"), opts, kea.DHCP4OptionSpace, optionDef4Lookup(client, host), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("option_data"), opts)...)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestOptionDataRoundTrip(t *testing.T) {
+	code, csv, never := 43, false, true
+	dhcp4, space := kea.DHCP4OptionSpace, "vendor-encapsulated-options-space"
+	want := []kea.OptionData{
+		{Name: "domain-name", Data: "example.com", Space: &dhcp4},
+		{Code: &code, Name: "vendor-encapsulated-options", Data: "0a0b", Space: &space, CSVFormat: &csv, NeverSend: &never, AlwaysSend: true},
+	}
+
+	got := optionDataFromSchema(optionDataToSchema(nil, want, kea.DHCP4OptionSpace))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("option-data did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestValidateOptionData(t *testing.T) {
+	opt := func(code int64, name string) optionDataResourceModel {
+		o := optionDataResourceModel{
+			Code:  types.Int64Null(),
+			Name:  types.StringNull(),
+			Data:  types.StringValue("192.168.230.1"),
+			Space: types.StringNull(),
+		}
+		if code != 0 {
+			o.Code = types.Int64Value(code)
+		}
+		if name != "" {
+			o.Name = types.StringValue(name)
+		}
+		return o
+	}
+
+	for _, tc := range []struct {
+		name    string
+		opts    []optionDataResourceModel
+		wantErr bool
+	}{
+		{name: "code and name", opts: []optionDataResourceModel{opt(3, "routers"), opt(6, "")}},
+		{name: "name only", opts: []optionDataResourceModel{opt(0, "routers"), opt(0, "domain-name-servers")}},
+		{name: "neither", opts: []optionDataResourceModel{opt(0, "")}, wantErr: true},
+		{name: "duplicate code", opts: []optionDataResourceModel{opt(3, "routers"), opt(3, "")}, wantErr: true},
+		{name: "duplicate name", opts: []optionDataResourceModel{opt(0, "routers"), opt(0, "routers")}, wantErr: true},
+	} {
+		var diags diag.Diagnostics
+		validateOptionData(path.Root("option_data"), tc.opts, kea.DHCP4OptionSpace, &diags)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: validateOptionData() errors = %v, want error %v", tc.name, diags, tc.wantErr)
+		}
+	}
+}
+
+func TestOptionDataResolveUnknown(t *testing.T) {
+	opts := []optionDataResourceModel{{Code: types.Int64Unknown(), Name: types.StringValue("routers")}}
+	optionDataResolveUnknown(opts)
+	if !opts[0].Code.IsNull() || opts[0].Name.ValueString() != "routers" {
+		t.Errorf("optionDataResolveUnknown() = %+v, want a null code and the configured name", opts[0])
+	}
+}
+
+func TestOptionDataResolve(t *testing.T) {
+	opt := func(code types.Int64, name types.String, data string, csv bool) optionDataResourceModel {
+		return optionDataResourceModel{Code: code, Name: name, Data: types.StringValue(data), Space: types.StringNull(), CSVFormat: types.BoolValue(csv)}
+	}
+
+	var diags diag.Diagnostics
+	got := optionDataResolve(path.Root("option_data"), []optionDataResourceModel{
+		opt(types.Int64Unknown(), types.StringValue("domain-name-servers"), "8.8.8.8, 4.2.2.2", true),
+		opt(types.Int64Value(3), types.StringUnknown(), "192.168.230.1", true),
+		opt(types.Int64Value(222), types.StringUnknown(), "anything", true),
+	}, kea.DHCP4OptionSpace, standardOptionDef4, &diags)
+	if diags.HasError() {
+		t.Fatalf("optionDataResolve() diagnostics: %v", diags)
+	}
+	if got[0].Code.ValueInt64() != 6 || got[1].Name.ValueString() != "routers" {
+		t.Errorf("optionDataResolve() = %+v, want code 6 and name routers filled in", got)
+	}
+	if !got[2].Name.IsUnknown() {
+		t.Errorf("custom option name = %v, want it left for Kea", got[2].Name)
+	}
+
+	for _, tc := range []struct {
+		name string
+		opt  optionDataResourceModel
+	}{
+		{"mismatched name", opt(types.Int64Value(3), types.StringValue("domain-name-servers"), "192.168.230.1", true)},
+		{"address", opt(types.Int64Null(), types.StringValue("routers"), "192.168.230.300", true)},
+		{"single value", opt(types.Int64Value(1), types.StringNull(), "255.255.255.0, 255.255.0.0", true)},
+		{"integer range", opt(types.Int64Value(23), types.StringNull(), "256", true)},
+		{"boolean", opt(types.Int64Value(19), types.StringNull(), "yes", true)},
+		{"record length", opt(types.Int64Value(81), types.StringNull(), "1, 2, 3", true)},
+		{"hexadecimal", opt(types.Int64Value(66), types.StringNull(), "not hex", false)},
+	} {
+		var diags diag.Diagnostics
+		optionDataResolve(path.Root("option_data"), []optionDataResourceModel{tc.opt}, kea.DHCP4OptionSpace, standardOptionDef4, &diags)
+		if !diags.HasError() {
+			t.Errorf("%s: optionDataResolve() succeeded, want an error", tc.name)
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		opt  optionDataResourceModel
+	}{
+		{"fqdn", opt(types.Int64Value(15), types.StringNull(), "example.com", true)},
+		{"record", opt(types.Int64Value(81), types.StringNull(), "1, 0, 0, host.example.com", true)},
+		{"escaped comma", opt(types.Int64Value(56), types.StringNull(), `hello\, world`, true)},
+		{"hexadecimal", opt(types.Int64Value(66), types.StringNull(), "746674702e6578616d706c652e636f6d", false)},
+		{"uint16 array", opt(types.Int64Value(25), types.StringNull(), "68, 296, 0x200", true)},
+	} {
+		var diags diag.Diagnostics
+		optionDataResolve(path.Root("option_data"), []optionDataResourceModel{tc.opt}, kea.DHCP4OptionSpace, standardOptionDef4, &diags)
+		if diags.HasError() {
+			t.Errorf("%s: optionDataResolve() diagnostics: %v", tc.name, diags)
+		}
+	}
+}
+
+func TestStandardOptionDefs4(t *testing.T) {
+	codes := make(map[int]bool)
+	names := make(map[string]bool)
+	for _, def := range kea.StandardOptionDefs4 {
+		if codes[def.Code] || names[def.Name] {
+			t.Errorf("option %d `%s` is defined more than once", def.Code, def.Name)
+		}
+		codes[def.Code], names[def.Name] = true, true
+		if def.Type != "internal" && !slices.Contains(kea.OptionDataTypes, def.Type) {
+			t.Errorf("option %d `%s` has unknown type `%s`", def.Code, def.Name, def.Type)
+		}
+	}
+}
+
+func TestOptionDefValidateRecordTypes(t *testing.T) {
+	for _, tc := range []struct {
+		typ, recordTypes string
+		wantErr          bool
+	}{
+		{typ: "string"},
+		{typ: "record", recordTypes: "uint16, ipv4-address"},
+		{typ: "record", wantErr: true},
+		{typ: "record", recordTypes: "uint16, record", wantErr: true},
+		{typ: "record", recordTypes: "uint16, ipv5-address", wantErr: true},
+		{typ: "uint32", recordTypes: "uint16", wantErr: true},
+	} {
+		def := kea.RemoteOptionDef4{Name: "test", Type: tc.typ, RecordTypes: tc.recordTypes}
+		if err := def.ValidateRecordTypes(); (err != nil) != tc.wantErr {
+			t.Errorf("ValidateRecordTypes(%s, %q) error = %v, want error %v", tc.typ, tc.recordTypes, err, tc.wantErr)
+		}
+	}
+}
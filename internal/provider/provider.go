@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
 )
@@ -26,8 +27,13 @@ type KeaCBProvider struct {
 
 // KeaProviderModel describes the provider data model.
 type KeaProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	PullAfterApply types.Bool   `tfsdk:"pull_after_apply"`
+	PullServers    types.List   `tfsdk:"pull_servers"`
+	RemoteType     types.String `tfsdk:"remote_type"`
+	RemoteHost     types.String `tfsdk:"remote_host"`
+	RemotePort     types.Int64  `tfsdk:"remote_port"`
 }
 
 // Metadata : Defines the provider metadata.
@@ -49,6 +55,32 @@ func (p *KeaCBProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"pull_after_apply": schema.BoolAttribute{
+				MarkdownDescription: "Send `config-backend-pull` after every successful configuration-backend write, so that " +
+					"changes take effect without waiting for `config-fetch-wait-time`. Defaults to `false`.",
+				Optional: true,
+			},
+			"pull_servers": schema.ListAttribute{
+				MarkdownDescription: "Hostnames of the Kea servers to send `config-backend-pull` to when `pull_after_apply` is " +
+					"enabled. Defaults to the `hostname` of the resource that was changed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"remote_type": schema.StringAttribute{
+				MarkdownDescription: remoteTypeDescription + " Sent as the `remote` of every configuration-backend command, " +
+					"resources and data sources can override it. Defaults to `postgresql`.",
+				Optional:   true,
+				Validators: []validator.String{oneOfValidator(kea.RemoteTypes...)},
+			},
+			"remote_host": schema.StringAttribute{
+				MarkdownDescription: remoteHostDescription + " Not sent when not specified.",
+				Optional:            true,
+			},
+			"remote_port": schema.Int64Attribute{
+				MarkdownDescription: remotePortDescription + " Not sent when not specified.",
+				Optional:            true,
+				Validators:          []validator.Int64{portValidator()},
+			},
 		},
 	}
 }
@@ -108,8 +140,22 @@ func (p *KeaCBProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	opts := []kea.Option{
+		kea.WithAuth(username, password),
+		kea.WithRemote(remoteSelector(config.RemoteType, config.RemoteHost, config.RemotePort)),
+	}
+	if config.PullAfterApply.ValueBool() {
+		servers := make([]string, 0, len(config.PullServers.Elements()))
+		resp.Diagnostics.Append(config.PullServers.ElementsAs(ctx, &servers, false)...)
+		opts = append(opts, kea.WithPullAfterApply(servers...))
+	}
+	// Stop here if there are any errors.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the Kea DHCP API client.
-	client := kea.New(kea.WithAuth(username, password))
+	client := kea.New(opts...)
 
 	// Make the Kea DHCP client available during DataSource and Resource
 	// type Configure methods.
@@ -121,8 +167,19 @@ func (p *KeaCBProvider) Configure(ctx context.Context, req provider.ConfigureReq
 func (p *KeaCBProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRemoteSubnet4Resource,
+		NewRemotePool4Resource,
 		NewRemoteOptionDef4Resource,
+		NewRemoteOptionDef6Resource,
+		NewRemoteOption6GlobalResource,
+		NewRemoteOption6NetworkResource,
+		NewRemoteOption6SubnetResource,
+		NewRemoteOption6PoolResource,
+		NewRemoteOption6PDPoolResource,
+		NewRemoteSharedNetwork6Resource,
+		NewRemoteGlobalParameter6Resource,
+		NewRemoteClientClass6Resource,
 		NewReservationResource,
+		NewReservation6Resource,
 	}
 }
 
@@ -131,7 +188,12 @@ func (p *KeaCBProvider) DataSources(_ context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		NewRemoteSubnet4DataSource,
 		NewRemoteOptionDef4DataSource,
+		NewRemoteOptionDefs4DataSource,
 		NewReservationDataSource,
+		NewConfigDataSource,
+		NewReservationsDataSource,
+		NewReservationSearchDataSource,
+		NewReservation6DataSource,
 	}
 }
 
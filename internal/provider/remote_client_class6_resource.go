@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &remoteClientClass6Resource{}
+	_ resource.ResourceWithImportState    = &remoteClientClass6Resource{}
+	_ resource.ResourceWithValidateConfig = &remoteClientClass6Resource{}
+)
+
+// NewRemoteClientClass6Resource : Creates a new empty resource client.
+func NewRemoteClientClass6Resource() resource.Resource {
+	return &remoteClientClass6Resource{}
+}
+
+type (
+	// remoteClientClass6Resource defines the resource implementation.
+	remoteClientClass6Resource struct {
+		client *kea.Client
+	}
+
+	// remoteClientClass6ResourceSchema describes the resource data model.
+	remoteClientClass6ResourceSchema struct {
+		Hostname          types.String              `tfsdk:"hostname"`
+		RemoteType        types.String              `tfsdk:"remote_type"`
+		RemoteHost        types.String              `tfsdk:"remote_host"`
+		RemotePort        types.Int64               `tfsdk:"remote_port"`
+		Name              types.String              `tfsdk:"name"`
+		Test              types.String              `tfsdk:"test"`
+		OnlyIfRequired    types.Bool                `tfsdk:"only_if_required"`
+		OptionData        []optionDataResourceModel `tfsdk:"option_data"`
+		PreferredLifetime types.Int64               `tfsdk:"preferred_lifetime"`
+		ValidLifetime     types.Int64               `tfsdk:"valid_lifetime"`
+		UserContext       jsonObjectValue           `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteClientClass6Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_client_class6_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteClientClass6Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote ClientClass6 resource, a dhcp6 client class in the configuration-backend. New classes are " +
+			"appended to the end of the class hierarchy, so classes depending on another class must depend on its resource.",
+		Attributes: remoteAttributes(map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the client class. e.g. `voip`. Changing the name forces a new class to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"test": schema.StringAttribute{
+				MarkdownDescription: "Expression matching the clients of the class. e.g. `substring(option[1].hex,0,4) == 0x00030001`",
+				Optional:            true,
+			},
+			"only_if_required": schema.BoolAttribute{
+				MarkdownDescription: "Only evaluate the class for subnets, shared-networks and pools listing it in `require_client_classes`.",
+				Optional:            true,
+			},
+			"option_data": optionDataAttribute(kea.DHCP6OptionSpace),
+			"preferred_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Preferred lifetime in seconds of the leases of clients in the class. e.g. `3000`",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Valid lifetime in seconds of the leases of clients in the class. e.g. `4000`",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"user_context": userContextAttribute("client class"),
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteClientClass6Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteClientClass6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteClientClass6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteClass6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteClass6Set(config.Hostname.ValueString(), remoteClientClass6FromSchema(config)); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteClass6Set",
+			fmt.Sprintf("Unable to create client class6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "ClientClass6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteClientClass6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteClientClass6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteClass6Get", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := client.RemoteClass6Get(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil {
+		// The class was removed outside of Terraform, or never existed when importing.
+		if errors.Is(err, kea.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"RemoteClass6Get",
+			fmt.Sprintf("Unable to read client class6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	remoteClientClass6ToSchema(&config, respData, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteClientClass6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteClientClass6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteClass6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteClass6Set(config.Hostname.ValueString(), remoteClientClass6FromSchema(config)); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteClass6Set",
+			fmt.Sprintf("Unable to update client class6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "ClientClass6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteClientClass6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteClientClass6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteClass6Del", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A class that is already gone needs no deleting.
+	// nolint: contextcheck
+	err := client.RemoteClass6Del(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"RemoteClass6Del",
+			fmt.Sprintf("Unable to delete client class6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "ClientClass6 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/name`, e.g. `kea.example.com/voip`.
+func (r *remoteClientClass6Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, name, err := parseNamedImportID(req.ID, "kea.example.com/voip")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// ValidateConfig : Validates that option-data codes are unique.
+func (r *remoteClientClass6Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remoteClientClass6ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP6OptionSpace, &resp.Diagnostics)
+}
+
+// remoteClientClass6FromSchema : Converts the resource model into a Kea client class.
+func remoteClientClass6FromSchema(config remoteClientClass6ResourceSchema) kea.RemoteClientClass6 {
+	return kea.RemoteClientClass6{
+		Name:              config.Name.ValueString(),
+		Test:              config.Test.ValueString(),
+		OnlyIfRequired:    config.OnlyIfRequired.ValueBoolPointer(),
+		OptionData:        optionDataFromSchema(config.OptionData),
+		PreferredLifetime: intPointer(config.PreferredLifetime),
+		ValidLifetime:     intPointer(config.ValidLifetime),
+		UserContext:       userContextFromSchema(config.UserContext),
+	}
+}
+
+// remoteClientClass6ToSchema : Writes the client class read from Kea into the resource model.
+func remoteClientClass6ToSchema(config *remoteClientClass6ResourceSchema, c kea.RemoteClientClass6, diags *diag.Diagnostics) {
+	config.Name = types.StringValue(c.Name)
+	config.Test = stringValueOrNull(c.Test)
+	// Kea reports only-if-required as false when it is not set, which keeps a prior null value.
+	if c.OnlyIfRequired == nil || *c.OnlyIfRequired || !config.OnlyIfRequired.IsNull() {
+		config.OnlyIfRequired = types.BoolPointerValue(c.OnlyIfRequired)
+	}
+	config.OptionData = optionDataToSchema(config.OptionData, c.OptionData, kea.DHCP6OptionSpace)
+	config.PreferredLifetime = int64PointerValue(c.PreferredLifetime)
+	config.ValidLifetime = int64PointerValue(c.ValidLifetime)
+	config.UserContext = userContextToSchema(config.UserContext, c.UserContext, diags)
+}
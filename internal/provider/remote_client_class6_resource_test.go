@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestRemoteClientClass6SchemaRoundTrip(t *testing.T) {
+	onlyIfRequired := false
+	want := kea.RemoteClientClass6{Name: "voip", Test: "member('ALL')", OnlyIfRequired: &onlyIfRequired, OptionData: []kea.OptionData{}}
+
+	var diags diag.Diagnostics
+	config := remoteClientClass6ResourceSchema{UserContext: jsonObjectValue{StringValue: types.StringNull()}}
+	remoteClientClass6ToSchema(&config, want, &diags)
+	if diags.HasError() {
+		t.Fatalf("remoteClientClass6ToSchema() diagnostics: %v", diags)
+	}
+	// The false Kea reports for an unset only-if-required keeps the attribute null.
+	if !config.OnlyIfRequired.IsNull() {
+		t.Errorf("remoteClientClass6ToSchema() only_if_required = %s, want null", config.OnlyIfRequired)
+	}
+	if got := remoteClientClass6FromSchema(config); got.Name != want.Name || got.Test != want.Test || got.OnlyIfRequired != nil {
+		t.Errorf("remoteClientClass6FromSchema() = %+v", got)
+	}
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &remoteGlobalParameter6Resource{}
+	_ resource.ResourceWithImportState = &remoteGlobalParameter6Resource{}
+)
+
+// NewRemoteGlobalParameter6Resource : Creates a new empty resource client.
+func NewRemoteGlobalParameter6Resource() resource.Resource {
+	return &remoteGlobalParameter6Resource{}
+}
+
+type (
+	// remoteGlobalParameter6Resource defines the resource implementation.
+	remoteGlobalParameter6Resource struct {
+		client *kea.Client
+	}
+
+	// remoteGlobalParameter6ResourceSchema describes the resource data model.
+	remoteGlobalParameter6ResourceSchema struct {
+		Hostname   types.String `tfsdk:"hostname"`
+		RemoteType types.String `tfsdk:"remote_type"`
+		RemoteHost types.String `tfsdk:"remote_host"`
+		RemotePort types.Int64  `tfsdk:"remote_port"`
+		Name       types.String `tfsdk:"name"`
+		Value      types.String `tfsdk:"value"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteGlobalParameter6Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_global_parameter6_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteGlobalParameter6Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote GlobalParameter6 resource, a single dhcp6 global parameter in the configuration-backend. " +
+			"Destroying it lets the servers fall back to their configuration file or built-in default.",
+		Attributes: remoteAttributes(map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the global parameter. e.g. `preferred-lifetime`. Changing the name forces a new parameter to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the global parameter. e.g. `3000`. Numbers and `true`/`false` are sent to Kea as JSON " +
+					"numbers and booleans, anything else as a string.",
+				Required: true,
+			},
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteGlobalParameter6Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteGlobalParameter6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteGlobalParameter6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteGlobalParameter6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	err := client.RemoteGlobalParameter6Set(
+		config.Hostname.ValueString(),
+		config.Name.ValueString(),
+		globalParameterFromString(config.Value.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteGlobalParameter6Set",
+			fmt.Sprintf("Unable to set global parameter6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "GlobalParameter6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteGlobalParameter6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteGlobalParameter6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteGlobalParameter6Get", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := client.RemoteGlobalParameter6Get(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteGlobalParameter6Get",
+			fmt.Sprintf("Unable to read global parameter6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// The parameter was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	value, err := globalParameterToString(respData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteGlobalParameter6Get",
+			fmt.Sprintf("Unable to read global parameter6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+	// Keep the configured spelling of numbers Kea reports differently, e.g. `0.50` as `0.5`.
+	if !globalParameterEqual(config.Value.ValueString(), value) {
+		config.Value = types.StringValue(value)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteGlobalParameter6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteGlobalParameter6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteGlobalParameter6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	err := client.RemoteGlobalParameter6Set(
+		config.Hostname.ValueString(),
+		config.Name.ValueString(),
+		globalParameterFromString(config.Value.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteGlobalParameter6Set",
+			fmt.Sprintf("Unable to update global parameter6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "GlobalParameter6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteGlobalParameter6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteGlobalParameter6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteGlobalParameter6Del", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A parameter that is already gone needs no deleting.
+	// nolint: contextcheck
+	err := client.RemoteGlobalParameter6Del(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"RemoteGlobalParameter6Del",
+			fmt.Sprintf("Unable to delete global parameter6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "GlobalParameter6 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/name`, e.g. `kea.example.com/preferred-lifetime`.
+func (r *remoteGlobalParameter6Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, name, err := parseNamedImportID(req.ID, "kea.example.com/preferred-lifetime")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// globalParameterFromString : Converts the value attribute into the JSON value Kea expects, numbers and
+// booleans are sent as such and anything else as a string.
+func globalParameterFromString(s string) any {
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if isJSONNumber(s) {
+		return json.Number(s)
+	}
+	return s
+}
+
+// globalParameterToString : Converts a global parameter read from Kea into the value attribute.
+// Maps and lists, which the configuration-backend stores for a few parameters, are encoded as JSON.
+func globalParameterToString(v any) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case json.Number:
+		return t.String(), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// globalParameterEqual : Reports whether two values are the same global parameter value, comparing numbers
+// by value.
+func globalParameterEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if !isJSONNumber(a) || !isJSONNumber(b) {
+		return false
+	}
+	x, _ := strconv.ParseFloat(a, 64)
+	y, _ := strconv.ParseFloat(b, 64)
+	return x == y
+}
+
+// isJSONNumber : Reports whether s is a number in JSON notation, e.g. `3000` or `0.5` but not `0x10` or `NaN`.
+func isJSONNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil && json.Valid([]byte(s))
+}
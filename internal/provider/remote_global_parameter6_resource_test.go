@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGlobalParameterValue(t *testing.T) {
+	for s, want := range map[string]any{
+		"3000":        json.Number("3000"),
+		"0.5":         json.Number("0.5"),
+		"true":        true,
+		"False":       "False",
+		"0x10":        "0x10",
+		"example.com": "example.com",
+	} {
+		if got := globalParameterFromString(s); got != want {
+			t.Errorf("globalParameterFromString(%q) = %#v, want %#v", s, got, want)
+		}
+	}
+
+	if got, err := globalParameterToString(map[string]any{"enable-updates": true}); err != nil || got != `{"enable-updates":true}` {
+		t.Errorf("globalParameterToString() = %q, %v", got, err)
+	}
+	if !globalParameterEqual("0.50", "0.5") || globalParameterEqual("1", "true") || globalParameterEqual("NaN", "NaN ") {
+		t.Error("globalParameterEqual() compared numbers incorrectly")
+	}
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+type (
+	// remoteOption6Model : The model of a remote-option6 resource, the option attributes along with the attributes
+	// of the scope it is set in. Implemented by a pointer to the resource model, so that Read can update it.
+	remoteOption6Model interface {
+		option() optionDataResourceModel
+		setOption(o optionDataResourceModel)
+		scope() remoteOption6Scope
+	}
+
+	// remoteOption6Scope : Where a remote-option6 resource sets its option, e.g. a subnet or a pool, and how the
+	// option is set, read back and deleted there.
+	remoteOption6Scope struct {
+		// command : Scope in the command names of the diagnostics, e.g. `Pool` for `RemoteOption6PoolSet`.
+		command string
+		// description : Scope in the diagnostic messages, e.g. `pool option6`.
+		description string
+
+		hostname   types.String
+		remoteType types.String
+		remoteHost types.String
+		remotePort types.Int64
+
+		// attributes : The scope attributes, e.g. `subnet_id`, which must be known and not empty.
+		attributes []remoteOption6ScopeAttribute
+
+		set func(client *kea.Client, opt kea.OptionData) error
+		// get : Returns the option, or nil when it or its scope is not found.
+		get func(client *kea.Client, space string, code int) (*kea.OptionData, error)
+		del func(client *kea.Client, space string, code int) error
+	}
+
+	// remoteOption6ScopeAttribute : A scope attribute of a remote-option6 resource.
+	remoteOption6ScopeAttribute struct {
+		name  string
+		value attr.Value
+	}
+)
+
+// remoteOption6Attributes : Returns the attributes of a remote-option6 resource, the hostname and the option
+// itself, along with the attributes identifying where the option is set, e.g. the subnet ID. The option code,
+// space and scope identify the option in Kea, so changing them replaces it.
+func remoteOption6Attributes(scope map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"hostname": schema.StringAttribute{
+			MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+			Required:            true,
+		},
+		"code": schema.Int64Attribute{
+			MarkdownDescription: "DHCPv6 option code, between 1 and 65535. e.g. `23` for `dns-servers`.",
+			Required:            true,
+			Validators:          []validator.Int64{optionCodeValidator(kea.DHCP6OptionSpace)},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Option name. e.g. `dns-servers`. Filled in from Kea when not set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"data": schema.StringAttribute{
+			MarkdownDescription: "Option value. e.g. `2001:db8::53, 2001:db8::54`",
+			Required:            true,
+		},
+		"space": schema.StringAttribute{
+			MarkdownDescription: "Option space. Defaults to `" + kea.DHCP6OptionSpace + "`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(kea.DHCP6OptionSpace),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"csv_format": schema.BoolAttribute{
+			MarkdownDescription: "Whether `data` is a comma separated list of values, rather than raw hexadecimal. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"always_send": schema.BoolAttribute{
+			MarkdownDescription: "Send the option even when the client did not request it. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"never_send": schema.BoolAttribute{
+			MarkdownDescription: "Never send the option, even when requested (Kea 2.2+). Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+	for k, v := range scope {
+		attributes[k] = v
+	}
+	return remoteAttributes(attributes)
+}
+
+// remoteOption6FromSchema : Converts the option attributes of a remote-option6 resource into Kea option-data.
+func remoteOption6FromSchema(o optionDataResourceModel) kea.OptionData {
+	return optionDataFromSchema([]optionDataResourceModel{o})[0]
+}
+
+// remoteOption6ToSchema : Converts the option-data read from Kea into the option attributes of a remote-option6
+// resource. The name is kept from the prior state when Kea does not report it.
+func remoteOption6ToSchema(prior optionDataResourceModel, o kea.OptionData) optionDataResourceModel {
+	m := optionDataToSchema(nil, []kea.OptionData{o}, kea.DHCP6OptionSpace)[0]
+	if m.Name.IsNull() && !prior.Name.IsUnknown() {
+		m.Name = prior.Name
+	}
+	return m
+}
+
+// subnet6Pool : Returns the address pool of the subnet6, comparing pools by their normalized notation.
+func subnet6Pool(subnet kea.RemoteSubnet6, pool string) *kea.Pool6 {
+	want := normalizeNetworkValue(networkKindPool, pool)
+	for i, p := range subnet.Pools {
+		if normalizeNetworkValue(networkKindPool, p.Pool) == want {
+			return &subnet.Pools[i]
+		}
+	}
+	return nil
+}
+
+// subnet6PDPool : Returns the prefix delegation pool of the subnet6 with the given prefix.
+func subnet6PDPool(subnet kea.RemoteSubnet6, prefix string) *kea.PDPool6 {
+	want := net.ParseIP(prefix)
+	for i, p := range subnet.PDPools {
+		if want != nil && want.Equal(net.ParseIP(p.Prefix)) {
+			return &subnet.PDPools[i]
+		}
+	}
+	return nil
+}
+
+// remoteOption6Set : Sets the option of a remote-option6 resource, on Create and Update, and saves the model
+// into the state. The verb is used in the diagnostics, e.g. `create`.
+func remoteOption6Set(ctx context.Context, base *kea.Client, m remoteOption6Model, verb string, state *tfsdk.State, diags *diag.Diagnostics) {
+	scope := m.scope()
+	summary := "RemoteOption6" + scope.command + "Set"
+	if !scope.validate(summary, diags) {
+		return
+	}
+	client := remoteClient(base, scope.remoteType, scope.remoteHost, scope.remotePort)
+
+	// nolint: contextcheck
+	if err := scope.set(client, remoteOption6FromSchema(m.option())); err != nil {
+		diags.AddError(summary, fmt.Sprintf("Unable to %s %s in Kea, got error: %s", verb, scope.description, err))
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, scope.hostname.ValueString(), "dhcp6", "Option6 was written to the configuration-backend", diags)
+
+	// The name is filled in from Kea on the next read.
+	o := m.option()
+	if o.Name.IsUnknown() {
+		o.Name = types.StringNull()
+		m.setOption(o)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, verb+"d a resource")
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, m)...)
+}
+
+// remoteOption6Read : Reads the option of a remote-option6 resource back from Kea into the state, removing the
+// resource when the option or its scope is gone.
+func remoteOption6Read(ctx context.Context, base *kea.Client, m remoteOption6Model, state *tfsdk.State, diags *diag.Diagnostics) {
+	scope := m.scope()
+	summary := "RemoteOption6" + scope.command + "Get"
+	if !scope.validate(summary, diags) {
+		return
+	}
+	client := remoteClient(base, scope.remoteType, scope.remoteHost, scope.remotePort)
+
+	o := m.option()
+	// nolint: contextcheck
+	respData, err := scope.get(client, o.Space.ValueString(), int(o.Code.ValueInt64()))
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Unable to read %s, got error: %s", scope.description, err))
+		return
+	}
+
+	// The option or its scope was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		state.RemoveResource(ctx)
+		return
+	}
+
+	m.setOption(remoteOption6ToSchema(o, *respData))
+
+	// Save updated data into Terraform state
+	diags.Append(state.Set(ctx, m)...)
+}
+
+// remoteOption6Delete : Deletes the option of a remote-option6 resource. An option that is already gone needs no
+// deleting.
+func remoteOption6Delete(base *kea.Client, m remoteOption6Model, diags *diag.Diagnostics) {
+	scope := m.scope()
+	summary := "RemoteOption6" + scope.command + "Del"
+	if !scope.validate(summary, diags) {
+		return
+	}
+	client := remoteClient(base, scope.remoteType, scope.remoteHost, scope.remotePort)
+
+	o := m.option()
+	// nolint: contextcheck
+	err := scope.del(client, o.Space.ValueString(), int(o.Code.ValueInt64()))
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		diags.AddError(summary, fmt.Sprintf("Unable to delete %s, got error: %s", scope.description, err))
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, scope.hostname.ValueString(), "dhcp6", "Option6 was deleted from the configuration-backend", diags)
+}
+
+// validate : Adds an error for the hostname and each scope attribute that is not set, and reports whether
+// the scope is complete.
+func (s remoteOption6Scope) validate(summary string, diags *diag.Diagnostics) bool {
+	ok := true
+	for _, a := range append([]remoteOption6ScopeAttribute{{name: "hostname", value: s.hostname}}, s.attributes...) {
+		str, isString := a.value.(interface{ ValueString() string })
+		if a.value.IsNull() || a.value.IsUnknown() || (isString && str.ValueString() == "") {
+			diags.AddError(summary, "`"+a.name+"` field is required")
+			ok = false
+		}
+	}
+	return ok
+}
+
+// remoteOption6SubnetGet : Returns a get function reading the option from the subnet6 with the ID, or from the
+// option-data picked by lookup within the subnet6, e.g. a pool. A missing subnet reads as a missing option.
+func remoteOption6SubnetGet(hostname string, subnetID int, lookup func(subnet kea.RemoteSubnet6) []kea.OptionData) func(*kea.Client, string, int) (*kea.OptionData, error) {
+	return func(client *kea.Client, space string, code int) (*kea.OptionData, error) {
+		subnet, err := client.RemoteSubnet6GetByID(hostname, subnetID)
+		if err != nil && !errors.Is(err, kea.ErrNotFound) {
+			return nil, fmt.Errorf("unable to read subnet6 %d: %w", subnetID, err)
+		}
+		return kea.FindOption(lookup(subnet), kea.DHCP6OptionSpace, space, code), nil
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &remoteOption6GlobalResource{}
+	_ resource.ResourceWithImportState = &remoteOption6GlobalResource{}
+)
+
+// NewRemoteOption6GlobalResource : Creates a new empty resource client.
+func NewRemoteOption6GlobalResource() resource.Resource {
+	return &remoteOption6GlobalResource{}
+}
+
+type (
+	// remoteOption6GlobalResource defines the resource implementation.
+	remoteOption6GlobalResource struct {
+		client *kea.Client
+	}
+
+	// remoteOption6GlobalResourceSchema describes the resource data model.
+	remoteOption6GlobalResourceSchema struct {
+		Hostname   types.String `tfsdk:"hostname"`
+		RemoteType types.String `tfsdk:"remote_type"`
+		RemoteHost types.String `tfsdk:"remote_host"`
+		RemotePort types.Int64  `tfsdk:"remote_port"`
+		Code       types.Int64  `tfsdk:"code"`
+		Name       types.String `tfsdk:"name"`
+		Data       types.String `tfsdk:"data"`
+		Space      types.String `tfsdk:"space"`
+		CSVFormat  types.Bool   `tfsdk:"csv_format"`
+		AlwaysSend types.Bool   `tfsdk:"always_send"`
+		NeverSend  types.Bool   `tfsdk:"never_send"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteOption6GlobalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_option6_global_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteOption6GlobalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote Option6 global resource, a DHCPv6 option set for every subnet in the configuration-backend",
+		Attributes:          remoteOption6Attributes(nil),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteOption6GlobalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteOption6GlobalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteOption6GlobalResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "create", &resp.State, &resp.Diagnostics)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteOption6GlobalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteOption6GlobalResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Read(ctx, r.client, &config, &resp.State, &resp.Diagnostics)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteOption6GlobalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteOption6GlobalResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "update", &resp.State, &resp.Diagnostics)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteOption6GlobalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteOption6GlobalResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Delete(r.client, &config, &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/space/code`, e.g. `kea.example.com/dhcp6/23`.
+func (r *remoteOption6GlobalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, _, space, code, err := parseOption6ImportID(req.ID, "hostname/space/code", "kea.example.com/dhcp6/23")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), int64(code))...)
+}
+
+// scope : Returns where the option is set, in the configuration-backend globals.
+func (m *remoteOption6GlobalResourceSchema) scope() remoteOption6Scope {
+	hostname := m.Hostname.ValueString()
+	return remoteOption6Scope{
+		command:     "Global",
+		description: "global option6",
+		hostname:    m.Hostname,
+		remoteType:  m.RemoteType,
+		remoteHost:  m.RemoteHost,
+		remotePort:  m.RemotePort,
+		set: func(client *kea.Client, opt kea.OptionData) error {
+			return client.RemoteOption6GlobalSet(hostname, opt)
+		},
+		get: func(client *kea.Client, space string, code int) (*kea.OptionData, error) {
+			return client.RemoteOption6GlobalGet(hostname, space, code)
+		},
+		del: func(client *kea.Client, space string, code int) error {
+			return client.RemoteOption6GlobalDel(hostname, space, code)
+		},
+	}
+}
+
+// option : Returns the option attributes of the resource model.
+func (m remoteOption6GlobalResourceSchema) option() optionDataResourceModel {
+	return optionDataResourceModel{
+		Code: m.Code, Name: m.Name, Data: m.Data, Space: m.Space,
+		CSVFormat: m.CSVFormat, AlwaysSend: m.AlwaysSend, NeverSend: m.NeverSend,
+	}
+}
+
+// setOption : Writes the option attributes into the resource model.
+func (m *remoteOption6GlobalResourceSchema) setOption(o optionDataResourceModel) {
+	m.Code, m.Name, m.Data, m.Space = o.Code, o.Name, o.Data, o.Space
+	m.CSVFormat, m.AlwaysSend, m.NeverSend = o.CSVFormat, o.AlwaysSend, o.NeverSend
+}
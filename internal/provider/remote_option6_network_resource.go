@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &remoteOption6NetworkResource{}
+	_ resource.ResourceWithImportState = &remoteOption6NetworkResource{}
+)
+
+// NewRemoteOption6NetworkResource : Creates a new empty resource client.
+func NewRemoteOption6NetworkResource() resource.Resource {
+	return &remoteOption6NetworkResource{}
+}
+
+type (
+	// remoteOption6NetworkResource defines the resource implementation.
+	remoteOption6NetworkResource struct {
+		client *kea.Client
+	}
+
+	// remoteOption6NetworkResourceSchema describes the resource data model.
+	remoteOption6NetworkResourceSchema struct {
+		Hostname          types.String `tfsdk:"hostname"`
+		RemoteType        types.String `tfsdk:"remote_type"`
+		RemoteHost        types.String `tfsdk:"remote_host"`
+		RemotePort        types.Int64  `tfsdk:"remote_port"`
+		SharedNetworkName types.String `tfsdk:"shared_network_name"`
+		Code              types.Int64  `tfsdk:"code"`
+		Name              types.String `tfsdk:"name"`
+		Data              types.String `tfsdk:"data"`
+		Space             types.String `tfsdk:"space"`
+		CSVFormat         types.Bool   `tfsdk:"csv_format"`
+		AlwaysSend        types.Bool   `tfsdk:"always_send"`
+		NeverSend         types.Bool   `tfsdk:"never_send"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteOption6NetworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_option6_network_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteOption6NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote Option6 network resource, a DHCPv6 option set on a shared-network in the configuration-backend",
+		Attributes: remoteOption6Attributes(map[string]schema.Attribute{
+			"shared_network_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the shared-network to set the option on. e.g. `lab-network6`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteOption6NetworkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteOption6NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteOption6NetworkResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "create", &resp.State, &resp.Diagnostics)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteOption6NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteOption6NetworkResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Read(ctx, r.client, &config, &resp.State, &resp.Diagnostics)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteOption6NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteOption6NetworkResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "update", &resp.State, &resp.Diagnostics)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteOption6NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteOption6NetworkResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Delete(r.client, &config, &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/shared_network_name/space/code`, e.g. `kea.example.com/lab-network6/dhcp6/23`.
+func (r *remoteOption6NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, scope, space, code, err := parseOption6ImportID(req.ID, "hostname/shared_network_name/space/code", "kea.example.com/lab-network6/dhcp6/23")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shared_network_name"), scope[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), int64(code))...)
+}
+
+// scope : Returns where the option is set, in the shared-network.
+func (m *remoteOption6NetworkResourceSchema) scope() remoteOption6Scope {
+	hostname, network := m.Hostname.ValueString(), m.SharedNetworkName.ValueString()
+	return remoteOption6Scope{
+		command:     "Network",
+		description: "shared-network option6",
+		hostname:    m.Hostname,
+		remoteType:  m.RemoteType,
+		remoteHost:  m.RemoteHost,
+		remotePort:  m.RemotePort,
+		attributes:  []remoteOption6ScopeAttribute{{name: "shared_network_name", value: m.SharedNetworkName}},
+		set: func(client *kea.Client, opt kea.OptionData) error {
+			return client.RemoteOption6NetworkSet(hostname, network, opt)
+		},
+		get: func(client *kea.Client, space string, code int) (*kea.OptionData, error) {
+			n, err := client.RemoteNetwork6Get(hostname, network)
+			if err != nil && !errors.Is(err, kea.ErrNotFound) {
+				return nil, fmt.Errorf("unable to read shared-network6 `%s`: %w", network, err)
+			}
+			return kea.FindOption(n.OptionData, kea.DHCP6OptionSpace, space, code), nil
+		},
+		del: func(client *kea.Client, space string, code int) error {
+			return client.RemoteOption6NetworkDel(hostname, network, space, code)
+		},
+	}
+}
+
+// option : Returns the option attributes of the resource model.
+func (m remoteOption6NetworkResourceSchema) option() optionDataResourceModel {
+	return optionDataResourceModel{
+		Code: m.Code, Name: m.Name, Data: m.Data, Space: m.Space,
+		CSVFormat: m.CSVFormat, AlwaysSend: m.AlwaysSend, NeverSend: m.NeverSend,
+	}
+}
+
+// setOption : Writes the option attributes into the resource model.
+func (m *remoteOption6NetworkResourceSchema) setOption(o optionDataResourceModel) {
+	m.Code, m.Name, m.Data, m.Space = o.Code, o.Name, o.Data, o.Space
+	m.CSVFormat, m.AlwaysSend, m.NeverSend = o.CSVFormat, o.AlwaysSend, o.NeverSend
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &remoteOption6PoolResource{}
+	_ resource.ResourceWithImportState = &remoteOption6PoolResource{}
+)
+
+// NewRemoteOption6PoolResource : Creates a new empty resource client.
+func NewRemoteOption6PoolResource() resource.Resource {
+	return &remoteOption6PoolResource{}
+}
+
+type (
+	// remoteOption6PoolResource defines the resource implementation.
+	remoteOption6PoolResource struct {
+		client *kea.Client
+	}
+
+	// remoteOption6PoolResourceSchema describes the resource data model.
+	remoteOption6PoolResourceSchema struct {
+		Hostname   types.String       `tfsdk:"hostname"`
+		RemoteType types.String       `tfsdk:"remote_type"`
+		RemoteHost types.String       `tfsdk:"remote_host"`
+		RemotePort types.Int64        `tfsdk:"remote_port"`
+		SubnetID   types.Int64        `tfsdk:"subnet_id"`
+		Pool       networkStringValue `tfsdk:"pool"`
+		Code       types.Int64        `tfsdk:"code"`
+		Name       types.String       `tfsdk:"name"`
+		Data       types.String       `tfsdk:"data"`
+		Space      types.String       `tfsdk:"space"`
+		CSVFormat  types.Bool         `tfsdk:"csv_format"`
+		AlwaysSend types.Bool         `tfsdk:"always_send"`
+		NeverSend  types.Bool         `tfsdk:"never_send"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteOption6PoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_option6_pool_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteOption6PoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote Option6 pool resource, a DHCPv6 option set on an address pool of a subnet6 in the configuration-backend",
+		Attributes: remoteOption6Attributes(map[string]schema.Attribute{
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the subnet6 holding the pool, used to read the option back. e.g. `1`",
+				Required:            true,
+				Validators:          []validator.Int64{subnetIDValidator()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "Address pool to set the option on, as configured in the subnet6. e.g. `2001:db8:1::10-2001:db8:1::ff`",
+				Required:            true,
+				CustomType:          poolType,
+				Validators:          []validator.String{pool6Validator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteOption6PoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteOption6PoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteOption6PoolResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "create", &resp.State, &resp.Diagnostics)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteOption6PoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteOption6PoolResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Read(ctx, r.client, &config, &resp.State, &resp.Diagnostics)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteOption6PoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteOption6PoolResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Set(ctx, r.client, &config, "update", &resp.State, &resp.Diagnostics)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteOption6PoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteOption6PoolResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteOption6Delete(r.client, &config, &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/subnet_id/pool/space/code`, e.g. `kea.example.com/1/2001:db8:1::10-2001:db8:1::ff/dhcp6/23`.
+func (r *remoteOption6PoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, scope, space, code, err := parseOption6ImportID(req.ID, "hostname/subnet_id/pool/space/code", "kea.example.com/1/2001:db8:1::10-2001:db8:1::ff/dhcp6/23")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	subnetID, err := parseSubnetID(req.ID, scope[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet_id"), int64(subnetID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool"), scope[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), int64(code))...)
+}
+
+// scope : Returns where the option is set, in the pool.
+func (m *remoteOption6PoolResourceSchema) scope() remoteOption6Scope {
+	hostname, pool := m.Hostname.ValueString(), m.Pool.ValueString()
+	return remoteOption6Scope{
+		command:     "Pool",
+		description: "pool option6",
+		hostname:    m.Hostname,
+		remoteType:  m.RemoteType,
+		remoteHost:  m.RemoteHost,
+		remotePort:  m.RemotePort,
+		attributes: []remoteOption6ScopeAttribute{
+			{name: "subnet_id", value: m.SubnetID},
+			{name: "pool", value: m.Pool},
+		},
+		set: func(client *kea.Client, opt kea.OptionData) error {
+			return client.RemoteOption6PoolSet(hostname, pool, opt)
+		},
+		get: remoteOption6SubnetGet(hostname, int(m.SubnetID.ValueInt64()), func(subnet kea.RemoteSubnet6) []kea.OptionData {
+			if p := subnet6Pool(subnet, pool); p != nil {
+				return p.OptionData
+			}
+			return nil
+		}),
+		del: func(client *kea.Client, space string, code int) error {
+			return client.RemoteOption6PoolDel(hostname, pool, space, code)
+		},
+	}
+}
+
+// option : Returns the option attributes of the resource model.
+func (m remoteOption6PoolResourceSchema) option() optionDataResourceModel {
+	return optionDataResourceModel{
+		Code: m.Code, Name: m.Name, Data: m.Data, Space: m.Space,
+		CSVFormat: m.CSVFormat, AlwaysSend: m.AlwaysSend, NeverSend: m.NeverSend,
+	}
+}
+
+// setOption : Writes the option attributes into the resource model.
+func (m *remoteOption6PoolResourceSchema) setOption(o optionDataResourceModel) {
+	m.Code, m.Name, m.Data, m.Space = o.Code, o.Name, o.Data, o.Space
+	m.CSVFormat, m.AlwaysSend, m.NeverSend = o.CSVFormat, o.AlwaysSend, o.NeverSend
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestRemoteOption6RoundTrip(t *testing.T) {
+	prior := optionDataResourceModel{
+		Code:       types.Int64Value(23),
+		Name:       types.StringValue("dns-servers"),
+		Data:       types.StringValue("2001:db8::53"),
+		Space:      types.StringValue(kea.DHCP6OptionSpace),
+		CSVFormat:  types.BoolValue(true),
+		AlwaysSend: types.BoolValue(true),
+		NeverSend:  types.BoolValue(false),
+	}
+
+	// Kea may leave out the name, which is then kept from the prior state.
+	o := remoteOption6FromSchema(prior)
+	o.Name = ""
+	if got := remoteOption6ToSchema(prior, o); got != prior {
+		t.Errorf("remoteOption6ToSchema() = %+v, want %+v", got, prior)
+	}
+}
+
+func TestSubnet6Pools(t *testing.T) {
+	code := 23
+	subnet := kea.RemoteSubnet6{
+		Pools:   []kea.Pool6{{Pool: "2001:db8:1::10-2001:db8:1::ff", OptionData: []kea.OptionData{{Code: &code, Data: "2001:db8::53"}}}},
+		PDPools: []kea.PDPool6{{Prefix: "2001:db8:8000::", PrefixLen: 48, DelegatedLen: 64}},
+	}
+
+	pool := subnet6Pool(subnet, "2001:db8:1::10 - 2001:db8:1::00ff")
+	if pool == nil || kea.FindOption(pool.OptionData, kea.DHCP6OptionSpace, kea.DHCP6OptionSpace, 23) == nil {
+		t.Errorf("subnet6Pool() = %+v, want the pool with option 23", pool)
+	}
+	if subnet6Pool(subnet, "2001:db8:1::100-2001:db8:1::1ff") != nil {
+		t.Error("subnet6Pool() found a pool that is not in the subnet")
+	}
+	if subnet6PDPool(subnet, "2001:0db8:8000::") == nil {
+		t.Error("subnet6PDPool() did not find the pd-pool")
+	}
+}
+
+func TestRemoteOption6ScopeValidate(t *testing.T) {
+	m := &remoteOption6PoolResourceSchema{
+		Hostname: types.StringValue("kea.example.com"),
+		SubnetID: types.Int64Unknown(),
+		Pool:     poolType.value(""),
+	}
+	var diags diag.Diagnostics
+	if m.scope().validate("RemoteOption6PoolSet", &diags) || diags.ErrorsCount() != 2 {
+		t.Errorf("validate() errors = %v, want `subnet_id` and `pool` reported", diags)
+	}
+
+	m.SubnetID = types.Int64Value(1)
+	m.Pool = poolType.value("2001:db8:1::10-2001:db8:1::ff")
+	diags = nil
+	if !m.scope().validate("RemoteOption6PoolSet", &diags) || diags.HasError() {
+		t.Errorf("validate() errors = %v, want none", diags)
+	}
+}
@@ -36,6 +36,9 @@ type (
 	// Maps to the source schema data.
 	remoteOptionDef4DataSourceSchema struct {
 		Hostname    types.String `tfsdk:"hostname"`
+		RemoteType  types.String `tfsdk:"remote_type"`
+		RemoteHost  types.String `tfsdk:"remote_host"`
+		RemotePort  types.Int64  `tfsdk:"remote_port"`
 		Name        types.String `tfsdk:"name"`
 		Code        types.Int64  `tfsdk:"code"`
 		Type        types.String `tfsdk:"type"`
@@ -56,7 +59,7 @@ func (d *remoteOptionDef4DataSource) Schema(_ context.Context, _ datasource.Sche
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Reservation data source",
-		Attributes: map[string]schema.Attribute{
+		Attributes: remoteDataSourceAttributes(map[string]schema.Attribute{
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
 				Required:            true,
@@ -74,7 +77,7 @@ func (d *remoteOptionDef4DataSource) Schema(_ context.Context, _ datasource.Sche
 			"array":        schema.BoolAttribute{Computed: true},
 			"record_types": schema.StringAttribute{Computed: true},
 			"encapsulate":  schema.StringAttribute{Computed: true},
-		},
+		}),
 	}
 }
 
@@ -105,6 +108,7 @@ func (d *remoteOptionDef4DataSource) Read(ctx context.Context, req datasource.Re
 	// Read Terraform configuration data into the model
 	// Also append any diagnostics to the diagnostics list.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	client := remoteClient(d.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	//  If the hostname value is empty, add an error to the diagnostics.
 	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
@@ -127,7 +131,7 @@ func (d *remoteOptionDef4DataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	// nolint: contextcheck
-	respData, err := d.client.RemoteOptionDef4Get(
+	respData, err := client.RemoteOptionDef4Get(
 		config.Hostname.ValueString(),
 		config.Space.ValueString(),
 		int(config.Code.ValueInt64()),
@@ -8,6 +8,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
@@ -15,8 +19,9 @@ import (
 
 var (
 	// Ensure provider defined types fully satisfy framework interfaces.
-	_ resource.Resource                = &remoteOptionDef4Resource{}
-	_ resource.ResourceWithImportState = &remoteOptionDef4Resource{}
+	_ resource.Resource                   = &remoteOptionDef4Resource{}
+	_ resource.ResourceWithImportState    = &remoteOptionDef4Resource{}
+	_ resource.ResourceWithValidateConfig = &remoteOptionDef4Resource{}
 )
 
 // NewRemoteOptionDef4Resource : Creates a new empty resource client.
@@ -33,6 +38,9 @@ type (
 	// remoteOptionDef4ResourceSchema describes the resource data model.
 	remoteOptionDef4ResourceSchema struct {
 		Hostname    types.String `tfsdk:"hostname"`
+		RemoteType  types.String `tfsdk:"remote_type"`
+		RemoteHost  types.String `tfsdk:"remote_host"`
+		RemotePort  types.Int64  `tfsdk:"remote_port"`
 		Name        types.String `tfsdk:"name"`
 		Code        types.Int64  `tfsdk:"code"`
 		Type        types.String `tfsdk:"type"`
@@ -54,7 +62,7 @@ func (r *remoteOptionDef4Resource) Schema(_ context.Context, _ resource.SchemaRe
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Remote OptionDef4 resource",
 
-		Attributes: map[string]schema.Attribute{
+		Attributes: remoteAttributes(map[string]schema.Attribute{
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
 				Required:            true,
@@ -64,30 +72,38 @@ func (r *remoteOptionDef4Resource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:            true,
 			},
 			"code": schema.Int64Attribute{
-				MarkdownDescription: "DHCP option code. e.g. `222`",
+				MarkdownDescription: "DHCP option code, between 1 and 254. e.g. `222`. Changing the code replaces the option-def.",
 				Required:            true,
+				Validators:          []validator.Int64{optionCodeValidator(kea.DHCP4OptionSpace)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "DHCP option type. e.g. `string`, `uint32`",
+				MarkdownDescription: "DHCP option type. One of `" + strings.Join(kea.OptionDataTypes, "`, `") + "`.",
 				Required:            true,
+				Validators:          []validator.String{oneOfValidator(kea.OptionDataTypes...)},
 			},
 			"space": schema.StringAttribute{
-				MarkdownDescription: "The DHCP space for the option-def. e.g. `dhcp4`.",
+				MarkdownDescription: "The DHCP space for the option-def. e.g. `dhcp4`. Changing the space replaces the option-def.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"array": schema.BoolAttribute{
 				MarkdownDescription: "The false value of the array parameter determines that the option does NOT comprise an array of uint32 values but is, instead, a single value..",
 				Optional:            true,
 			},
 			"record_types": schema.StringAttribute{
-				MarkdownDescription: "The record_types value should be non-empty if type is set to \"record\"; otherwise it must be left blank. ",
+				MarkdownDescription: "Comma separated field types of a `record` option. e.g. `uint16, ipv4-address`. Required if type is set to `record`; otherwise it must be left blank.",
 				Optional:            true,
 			},
 			"encapsulate": schema.StringAttribute{
 				MarkdownDescription: "The name of the option space in which the sub-options are defined.",
 				Optional:            true,
 			},
-		},
+		}),
 	}
 }
 
@@ -117,6 +133,7 @@ func (r *remoteOptionDef4Resource) Create(ctx context.Context, req resource.Crea
 	// Read Terraform configuration data into the model
 	// Also append any diagnostics to the diagnostics list.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	//  If the hostname value is empty, add an error to the diagnostics.
 	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
@@ -166,7 +183,7 @@ func (r *remoteOptionDef4Resource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// nolint: contextcheck
-	if err := r.client.RemoteOptionDef4Set(config.Hostname.ValueString(), def); err != nil {
+	if err := client.RemoteOptionDef4Set(config.Hostname.ValueString(), def); err != nil {
 		resp.Diagnostics.AddError(
 			"RemoteOptionDef4Set",
 			fmt.Sprintf("Unable to create option-def4 in Kea, got error: %s | %v", err, def),
@@ -174,6 +191,10 @@ func (r *remoteOptionDef4Resource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Option-def4 was written to the configuration-backend", &resp.Diagnostics)
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
@@ -188,6 +209,7 @@ func (r *remoteOptionDef4Resource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	//  If the hostname value is empty, add an error to the diagnostics.
 	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
@@ -210,7 +232,7 @@ func (r *remoteOptionDef4Resource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// nolint: contextcheck
-	respData, err := r.client.RemoteOptionDef4Get(
+	respData, err := client.RemoteOptionDef4Get(
 		config.Hostname.ValueString(),
 		config.Space.ValueString(),
 		int(config.Code.ValueInt64()),
@@ -226,6 +248,12 @@ func (r *remoteOptionDef4Resource) Read(ctx context.Context, req resource.ReadRe
 		}
 	}
 
+	// The option-def was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// If there are any diagnostics errors, stop here.
 	if resp.Diagnostics.HasError() {
 		return
@@ -233,6 +261,9 @@ func (r *remoteOptionDef4Resource) Read(ctx context.Context, req resource.ReadRe
 
 	// Marshalling the response data taken from Kea, and write
 	// it into the TF  model.
+	if respData.Name != "" {
+		config.Name = types.StringValue(respData.Name)
+	}
 	if respData.Type != "" {
 		config.Type = types.StringValue(respData.Type)
 	}
@@ -261,6 +292,7 @@ func (r *remoteOptionDef4Resource) Update(ctx context.Context, req resource.Upda
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	//  If the hostname value is empty, add an error to the diagnostics.
 	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
@@ -310,7 +342,7 @@ func (r *remoteOptionDef4Resource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// nolint: contextcheck
-	if err := r.client.RemoteOptionDef4Set(config.Hostname.ValueString(), def); err != nil {
+	if err := client.RemoteOptionDef4Set(config.Hostname.ValueString(), def); err != nil {
 		resp.Diagnostics.AddError(
 			"RemoteOptionDef4Update",
 			fmt.Sprintf("Unable to update remote-option-def4 in Kea, got error: %s | %v", err, def),
@@ -318,6 +350,10 @@ func (r *remoteOptionDef4Resource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Option-def4 was written to the configuration-backend", &resp.Diagnostics)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
@@ -328,6 +364,7 @@ func (r *remoteOptionDef4Resource) Delete(ctx context.Context, req resource.Dele
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	//  If the hostname value is empty, add an error to the diagnostics.
 	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
@@ -350,16 +387,48 @@ func (r *remoteOptionDef4Resource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// nolint: contextcheck
-	if err := r.client.RemoteOptionDef4Del(config.Hostname.ValueString(), config.Space.ValueString(), int(config.Code.ValueInt64())); err != nil {
+	if err := client.RemoteOptionDef4Del(config.Hostname.ValueString(), config.Space.ValueString(), int(config.Code.ValueInt64())); err != nil {
 		resp.Diagnostics.AddError(
 			"RemoteOptionDef4Del",
 			fmt.Sprintf("Unable to delete remote-option-def4, got error: %s", err),
 		)
 		return
 	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Option-def4 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ValidateConfig : Validates that `record_types` is set for, and only for, `record` option-defs.
+func (r *remoteOptionDef4Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remoteOptionDef4ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values can't be validated until apply.
+	if config.Type.IsUnknown() || config.RecordTypes.IsUnknown() {
+		return
+	}
+
+	def := kea.RemoteOptionDef4{Type: config.Type.ValueString(), RecordTypes: config.RecordTypes.ValueString()}
+	if err := def.ValidateRecordTypes(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("record_types"), "Invalid Record Types", err.Error())
+	}
 }
 
 // ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/space/code`, e.g. `kea.example.com/dhcp4/222`.
 func (r *remoteOptionDef4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("code"), req, resp)
+	hostname, space, code, err := parseOptionDef4ImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), int64(code))...)
 }
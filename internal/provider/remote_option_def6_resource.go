@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &remoteOptionDef6Resource{}
+	_ resource.ResourceWithImportState    = &remoteOptionDef6Resource{}
+	_ resource.ResourceWithValidateConfig = &remoteOptionDef6Resource{}
+)
+
+// NewRemoteOptionDef6Resource : Creates a new empty resource client.
+func NewRemoteOptionDef6Resource() resource.Resource {
+	return &remoteOptionDef6Resource{}
+}
+
+type (
+	// remoteOptionDef6Resource defines the resource implementation.
+	remoteOptionDef6Resource struct {
+		client *kea.Client
+	}
+
+	// remoteOptionDef6ResourceSchema describes the resource data model.
+	remoteOptionDef6ResourceSchema struct {
+		Hostname    types.String `tfsdk:"hostname"`
+		RemoteType  types.String `tfsdk:"remote_type"`
+		RemoteHost  types.String `tfsdk:"remote_host"`
+		RemotePort  types.Int64  `tfsdk:"remote_port"`
+		Name        types.String `tfsdk:"name"`
+		Code        types.Int64  `tfsdk:"code"`
+		Type        types.String `tfsdk:"type"`
+		Array       types.Bool   `tfsdk:"array"`
+		RecordTypes types.String `tfsdk:"record_types"`
+		Space       types.String `tfsdk:"space"`
+		Encapsulate types.String `tfsdk:"encapsulate"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteOptionDef6Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_option_def6_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteOptionDef6Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote OptionDef6 resource, a DHCPv6 option definition",
+
+		Attributes: remoteAttributes(map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "DHCP option name. e.g. `vendor-location`",
+				Required:            true,
+			},
+			"code": schema.Int64Attribute{
+				MarkdownDescription: "DHCP option code, between 1 and 65535. e.g. `1234`. Changing the code replaces the option-def.",
+				Required:            true,
+				Validators:          []validator.Int64{optionCodeValidator(kea.DHCP6OptionSpace)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "DHCP option type. One of `" + strings.Join(kea.OptionDataTypes, "`, `") + "`.",
+				Required:            true,
+				Validators:          []validator.String{oneOfValidator(kea.OptionDataTypes...)},
+			},
+			"space": schema.StringAttribute{
+				MarkdownDescription: "The DHCP space for the option-def. e.g. `dhcp6`. Changing the space replaces the option-def.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"array": schema.BoolAttribute{
+				MarkdownDescription: "The false value of the array parameter determines that the option does NOT comprise an array of uint32 values but is, instead, a single value..",
+				Optional:            true,
+			},
+			"record_types": schema.StringAttribute{
+				MarkdownDescription: "Comma separated field types of a `record` option. e.g. `uint16, ipv4-address`. Required if type is set to `record`; otherwise it must be left blank.",
+				Optional:            true,
+			},
+			"encapsulate": schema.StringAttribute{
+				MarkdownDescription: "The name of the option space in which the sub-options are defined.",
+				Optional:            true,
+			},
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteOptionDef6Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteOptionDef6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteOptionDef6ResourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Set", "`hostname` field is required")
+	}
+
+	//  If the Code value is empty, add an error to the diagnostics.
+	if config.Code.IsNull() || config.Code.IsUnknown() {
+		resp.Diagnostics.AddError("RemoteOptionDef6Set", "`code` field is required")
+	}
+
+	//  If the Name value is empty, add an error to the diagnostics.
+	if config.Name.IsNull() || config.Name.IsUnknown() || config.Name.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Set", "`name` field is required")
+	}
+
+	//  If the Type value is empty, add an error to the diagnostics.
+	if config.Type.IsNull() || config.Type.IsUnknown() || config.Type.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Set", "`type` field is required")
+	}
+
+	//  If the Space value is empty, add an error to the diagnostics.
+	if config.Space.IsNull() || config.Space.IsUnknown() || config.Space.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Set", "`space` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	def := kea.RemoteOptionDef6{
+		Name:  config.Name.ValueString(),
+		Code:  int(config.Code.ValueInt64()),
+		Type:  config.Type.ValueString(),
+		Space: config.Space.ValueString(),
+	}
+
+	if !config.Array.IsNull() && !config.Array.IsUnknown() {
+		def.Array = config.Array.ValueBool()
+	}
+	if !config.RecordTypes.IsNull() && !config.RecordTypes.IsUnknown() {
+		def.RecordTypes = config.RecordTypes.ValueString()
+	}
+	if !config.Encapsulate.IsNull() && !config.Encapsulate.IsUnknown() {
+		def.Encapsulate = config.Encapsulate.ValueString()
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteOptionDef6Set(config.Hostname.ValueString(), def); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteOptionDef6Set",
+			fmt.Sprintf("Unable to create option-def6 in Kea, got error: %s | %v", err, def),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "Option-def6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteOptionDef6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteOptionDef6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Get", "`hostname` field is required")
+	}
+
+	//  If the Code value is empty, add an error to the diagnostics.
+	if config.Code.IsNull() || config.Code.IsUnknown() {
+		resp.Diagnostics.AddError("RemoteOptionDef6Get", "`code` field is required")
+	}
+
+	//  If the Space value is empty, add an error to the diagnostics.
+	if config.Space.IsNull() || config.Space.IsUnknown() || config.Space.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Get", "`space` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := client.RemoteOptionDef6Get(
+		config.Hostname.ValueString(),
+		config.Space.ValueString(),
+		int(config.Code.ValueInt64()),
+	)
+	if err != nil {
+		// Only return an error if the error is NOT option-def not found.
+		if !strings.Contains(err.Error(), "not found") {
+			resp.Diagnostics.AddError(
+				"RemoteOptionDef6Get",
+				fmt.Sprintf("Unable to read remote-option-def6, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	// The option-def was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshalling the response data taken from Kea, and write
+	// it into the TF  model.
+	if respData.Name != "" {
+		config.Name = types.StringValue(respData.Name)
+	}
+	if respData.Type != "" {
+		config.Type = types.StringValue(respData.Type)
+	}
+	if respData.Array {
+		config.Array = types.BoolValue(respData.Array)
+	}
+	if respData.RecordTypes != "" {
+		config.RecordTypes = types.StringValue(respData.RecordTypes)
+	}
+	if respData.Encapsulate != "" {
+		config.Encapsulate = types.StringValue(respData.Encapsulate)
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteOptionDef6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteOptionDef6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Update", "`hostname` field is required")
+	}
+
+	//  If the Code value is empty, add an error to the diagnostics.
+	if config.Code.IsNull() || config.Code.IsUnknown() {
+		resp.Diagnostics.AddError("RemoteOptionDef6Update", "`code` field is required")
+	}
+
+	//  If the Name value is empty, add an error to the diagnostics.
+	if config.Name.IsNull() || config.Name.IsUnknown() || config.Name.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Update", "`name` field is required")
+	}
+
+	//  If the Type value is empty, add an error to the diagnostics.
+	if config.Type.IsNull() || config.Type.IsUnknown() || config.Type.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Update", "`type` field is required")
+	}
+
+	//  If the Space value is empty, add an error to the diagnostics.
+	if config.Space.IsNull() || config.Space.IsUnknown() || config.Space.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Update", "`space` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	def := kea.RemoteOptionDef6{
+		Name:  config.Name.ValueString(),
+		Code:  int(config.Code.ValueInt64()),
+		Type:  config.Type.ValueString(),
+		Space: config.Space.ValueString(),
+	}
+
+	if !config.Array.IsNull() && !config.Array.IsUnknown() {
+		def.Array = config.Array.ValueBool()
+	}
+	if !config.RecordTypes.IsNull() && !config.RecordTypes.IsUnknown() {
+		def.RecordTypes = config.RecordTypes.ValueString()
+	}
+	if !config.Encapsulate.IsNull() && !config.Encapsulate.IsUnknown() {
+		def.Encapsulate = config.Encapsulate.ValueString()
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteOptionDef6Set(config.Hostname.ValueString(), def); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteOptionDef6Update",
+			fmt.Sprintf("Unable to update remote-option-def6 in Kea, got error: %s | %v", err, def),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "Option-def6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteOptionDef6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteOptionDef6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Del", "`hostname` field is required")
+	}
+
+	//  If the Code value is empty, add an error to the diagnostics.
+	if config.Code.IsNull() || config.Code.IsUnknown() {
+		resp.Diagnostics.AddError("RemoteOptionDef6Del", "`code` field is required")
+	}
+
+	//  If the Space value is empty, add an error to the diagnostics.
+	if config.Space.IsNull() || config.Space.IsUnknown() || config.Space.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef6Del", "`space` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteOptionDef6Del(config.Hostname.ValueString(), config.Space.ValueString(), int(config.Code.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteOptionDef6Del",
+			fmt.Sprintf("Unable to delete remote-option-def6, got error: %s", err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "Option-def6 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ValidateConfig : Validates that `record_types` is set for, and only for, `record` option-defs.
+func (r *remoteOptionDef6Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remoteOptionDef6ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values can't be validated until apply.
+	if config.Type.IsUnknown() || config.RecordTypes.IsUnknown() {
+		return
+	}
+
+	def := kea.RemoteOptionDef4{Type: config.Type.ValueString(), RecordTypes: config.RecordTypes.ValueString()}
+	if err := def.ValidateRecordTypes(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("record_types"), "Invalid Record Types", err.Error())
+	}
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/space/code`, e.g. `kea.example.com/dhcp6/1234`.
+func (r *remoteOptionDef6Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, space, code, err := parseOptionDef6ImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), int64(code))...)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &remoteOptionDefs4DataSource{}
+	_ datasource.DataSourceWithConfigure = &remoteOptionDefs4DataSource{}
+)
+
+// NewRemoteOptionDefs4DataSource : Creates a new empty data source client.
+func NewRemoteOptionDefs4DataSource() datasource.DataSource {
+	return &remoteOptionDefs4DataSource{}
+}
+
+type (
+	// remoteOptionDefs4DataSource defines the data source client.
+	remoteOptionDefs4DataSource struct {
+		client *kea.Client
+	}
+
+	// remoteOptionDefs4DataSourceSchema describes the data source data model.
+	// Maps to the source schema data.
+	remoteOptionDefs4DataSourceSchema struct {
+		Hostname   types.String                `tfsdk:"hostname"`
+		RemoteType types.String                `tfsdk:"remote_type"`
+		RemoteHost types.String                `tfsdk:"remote_host"`
+		RemotePort types.Int64                 `tfsdk:"remote_port"`
+		Space      types.String                `tfsdk:"space"`
+		OptionDefs []remoteOptionDef4ListModel `tfsdk:"option_defs"`
+	}
+
+	// remoteOptionDef4ListModel : Represents a single option-def in a list of option-defs.
+	remoteOptionDef4ListModel struct {
+		Name        types.String `tfsdk:"name"`
+		Code        types.Int64  `tfsdk:"code"`
+		Type        types.String `tfsdk:"type"`
+		Array       types.Bool   `tfsdk:"array"`
+		RecordTypes types.String `tfsdk:"record_types"`
+		Space       types.String `tfsdk:"space"`
+		Encapsulate types.String `tfsdk:"encapsulate"`
+	}
+)
+
+// Metadata : Defines the data source metadata.
+func (d *remoteOptionDefs4DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_option_defs4_data_source"
+}
+
+// Schema : Defines the data source schema.
+func (d *remoteOptionDefs4DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote option-defs data source, lists the option-defs of the dhcp4 configuration-backend.",
+		Attributes: remoteDataSourceAttributes(map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"space": schema.StringAttribute{
+				MarkdownDescription: "Only list the option-defs of this DHCP space. e.g. `dhcp4`. Unset lists every space.",
+				Optional:            true,
+			},
+			"option_defs": schema.ListNestedAttribute{
+				MarkdownDescription: "Every option-def in the configuration-backend, ordered as Kea returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":         schema.StringAttribute{Computed: true},
+						"code":         schema.Int64Attribute{Computed: true},
+						"type":         schema.StringAttribute{Computed: true},
+						"array":        schema.BoolAttribute{Computed: true},
+						"record_types": schema.StringAttribute{Computed: true},
+						"space":        schema.StringAttribute{Computed: true},
+						"encapsulate":  schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// Configure : Configures the data source client.
+func (d *remoteOptionDefs4DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read : Reads the data source data into the Terraform state.
+func (d *remoteOptionDefs4DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Define an empty configuration.
+	var config remoteOptionDefs4DataSourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	client := remoteClient(d.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteOptionDef4GetAll", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := client.RemoteOptionDef4GetAll(config.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteOptionDef4GetAll",
+			fmt.Sprintf("Unable to list the remote-option-def4 entries, got error: %s", err),
+		)
+		return
+	}
+
+	config.OptionDefs = remoteOptionDef4ListModels(respData, config.Space.ValueString())
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// remoteOptionDef4ListModels : Converts Kea option-defs into the list model, keeping only those of
+// the given space unless it is empty. Option-defs without a space belong to `dhcp4`.
+func remoteOptionDef4ListModels(defs []kea.RemoteOptionDef4, space string) []remoteOptionDef4ListModel {
+	ret := make([]remoteOptionDef4ListModel, 0, len(defs))
+	for _, def := range defs {
+		if def.Space == "" {
+			def.Space = kea.DHCP4OptionSpace
+		}
+		if space != "" && def.Space != space {
+			continue
+		}
+		ret = append(ret, remoteOptionDef4ListModel{
+			Name:        types.StringValue(def.Name),
+			Code:        types.Int64Value(int64(def.Code)),
+			Type:        types.StringValue(def.Type),
+			Array:       types.BoolValue(def.Array),
+			RecordTypes: types.StringValue(def.RecordTypes),
+			Space:       types.StringValue(def.Space),
+			Encapsulate: types.StringValue(def.Encapsulate),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var testAccRemoteOptionDefs4DataSourceConfig = fmt.Sprintf(`
+data "kea_remote_option_defs4_data_source" "test" {
+  hostname = "%s"
+  space    = "dhcp4"
+}`, testAccHostname)
+
+func TestAccRemoteOptionDefs4DataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccRemoteOptionDefs4DataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kea_remote_option_defs4_data_source.test", "hostname", testAccHostname),
+					resource.TestCheckResourceAttrSet("data.kea_remote_option_defs4_data_source.test", "option_defs.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestRemoteOptionDef4ListModels(t *testing.T) {
+	defs := []kea.RemoteOptionDef4{
+		{Code: 222, Name: "location-identifier", Type: "string"},
+		{Code: 1, Name: "controller", Type: "ipv4-address", Space: kea.VendorEncapsulatedOptionsSpace},
+	}
+
+	got := remoteOptionDef4ListModels(defs, kea.DHCP4OptionSpace)
+	if len(got) != 1 || got[0].Code.ValueInt64() != 222 || got[0].Space.ValueString() != kea.DHCP4OptionSpace {
+		t.Errorf("remoteOptionDef4ListModels(dhcp4) = %+v, want option 222 in dhcp4", got)
+	}
+	if got := remoteOptionDef4ListModels(defs, ""); len(got) != 2 {
+		t.Errorf("remoteOptionDef4ListModels() returned %d option-defs, want 2", len(got))
+	}
+}
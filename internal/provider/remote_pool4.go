@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// pool4Attributes : Returns the attributes of a DHCPv4 pool, shared by the subnet pools and the standalone pool resource.
+func pool4Attributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"pool": schema.StringAttribute{
+			MarkdownDescription: "Address range or prefix of the pool. e.g. `192.168.230.10-192.168.230.200` or `192.168.230.64/26`",
+			Required:            true,
+			CustomType:          poolType,
+			Validators:          []validator.String{poolValidator()},
+		},
+		"option_data": optionDataAttribute(kea.DHCP4OptionSpace),
+		"client_class": schema.StringAttribute{
+			MarkdownDescription: "Only assign addresses from the pool to clients of this class. e.g. `voip`",
+			Optional:            true,
+		},
+		"require_client_classes": schema.ListAttribute{
+			MarkdownDescription: "Classes with `only-if-required` to evaluate for clients getting an address from the pool. e.g. `[\"voip\"]`",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"user_context": userContextAttribute("pool"),
+	}
+}
+
+// pool4FromSchema : Converts a pool of the resource model into a Kea pool.
+func pool4FromSchema(p remoteSubnet4PoolResourceModel) kea.Pool {
+	return kea.Pool{
+		Pool:                 p.Pool.ValueString(),
+		OptionData:           optionDataFromSchema(p.OptionData),
+		ClientClass:          p.ClientClass.ValueString(),
+		RequireClientClasses: stringListElements(p.RequireClientClasses),
+		UserContext:          userContextFromSchema(p.UserContext),
+	}
+}
+
+// pool4ToSchema : Converts a Kea pool into the resource model. The prior pool with the same range, when there
+// is one, keeps its notation of the range, and its null attributes null when Kea reports them empty.
+func pool4ToSchema(prior []remoteSubnet4PoolResourceModel, p kea.Pool, diags *diag.Diagnostics) remoteSubnet4PoolResourceModel {
+	m := remoteSubnet4PoolResourceModel{
+		RequireClientClasses: types.ListNull(types.StringType),
+		UserContext:          jsonObjectValue{StringValue: types.StringNull()},
+	}
+	m.Pool = poolType.value(p.Pool)
+	want := normalizeNetworkValue(networkKindPool, p.Pool)
+	for _, v := range prior {
+		if !v.Pool.IsNull() && !v.Pool.IsUnknown() && normalizeNetworkValue(networkKindPool, v.Pool.ValueString()) == want {
+			m = v
+			break
+		}
+	}
+
+	m.OptionData = optionDataToSchema(m.OptionData, p.OptionData, kea.DHCP4OptionSpace)
+	m.ClientClass = stringValueOrNull(p.ClientClass)
+	m.RequireClientClasses = stringListValue(m.RequireClientClasses, p.RequireClientClasses, diags)
+	m.UserContext = userContextToSchema(m.UserContext, p.UserContext, diags)
+	return m
+}
+
+// pool4sFromSchema : Converts the pools of the resource model into Kea pools.
+func pool4sFromSchema(pools []remoteSubnet4PoolResourceModel) []kea.Pool {
+	fr := make([]kea.Pool, 0, len(pools))
+	for _, p := range pools {
+		fr = append(fr, pool4FromSchema(p))
+	}
+	return fr
+}
+
+// pool4sToSchema : Converts Kea pools into the resource model, see pool4ToSchema.
+func pool4sToSchema(prior []remoteSubnet4PoolResourceModel, pools []kea.Pool, diags *diag.Diagnostics) []remoteSubnet4PoolResourceModel {
+	fr := make([]remoteSubnet4PoolResourceModel, 0, len(pools))
+	for _, p := range pools {
+		fr = append(fr, pool4ToSchema(prior, p, diags))
+	}
+	return fr
+}
+
+// pool4sResolveUnknown : Nulls the option_data codes and names of the pools left for Kea to compute, see
+// optionDataResolveUnknown.
+func pool4sResolveUnknown(pools []remoteSubnet4PoolResourceModel) {
+	for i := range pools {
+		optionDataResolveUnknown(pools[i].OptionData)
+	}
+}
+
+// validatePool4sOptionData : Validates the option_data of every pool, see validateOptionData.
+func validatePool4sOptionData(pools []remoteSubnet4PoolResourceModel, diags *diag.Diagnostics) {
+	for _, p := range pools {
+		validateOptionData(path.Root("pools"), p.OptionData, kea.DHCP4OptionSpace, diags)
+		optionDataResolve(path.Root("pools"), p.OptionData, kea.DHCP4OptionSpace, standardOptionDef4, diags)
+	}
+}
+
+// planPool4sOptionData : Resolves the planned option_data of every pool against the DHCPv4 option definitions,
+// see optionDataResolve.
+func planPool4sOptionData(ctx context.Context, client *kea.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var hostname types.String
+	var set types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hostname"), &hostname)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("pools"), &set)...)
+	if resp.Diagnostics.HasError() || set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	var pools []remoteSubnet4PoolResourceModel
+	resp.Diagnostics.Append(set.ElementsAs(ctx, &pools, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	host := ""
+	if !hostname.IsUnknown() {
+		host = hostname.ValueString()
+	}
+	lookup := optionDef4Lookup(client, host)
+	for i := range pools {
+		if pools[i].OptionData != nil {
+			pools[i].OptionData = optionDataResolve(path.Root("pools"), pools[i].OptionData, kea.DHCP4OptionSpace, lookup, &resp.Diagnostics)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pools"), pools)...)
+}
+
+// subnet4Pool : Returns the pool of the subnet4, comparing pools by their normalized notation.
+func subnet4Pool(subnet kea.RemoteSubnet4, pool string) *kea.Pool {
+	want := normalizeNetworkValue(networkKindPool, pool)
+	for i, p := range subnet.Pools {
+		if normalizeNetworkValue(networkKindPool, p.Pool) == want {
+			return &subnet.Pools[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &remotePool4Resource{}
+	_ resource.ResourceWithImportState    = &remotePool4Resource{}
+	_ resource.ResourceWithModifyPlan     = &remotePool4Resource{}
+	_ resource.ResourceWithValidateConfig = &remotePool4Resource{}
+)
+
+// NewRemotePool4Resource : Creates a new empty resource client.
+func NewRemotePool4Resource() resource.Resource {
+	return &remotePool4Resource{}
+}
+
+type (
+	// remotePool4Resource defines the resource implementation.
+	remotePool4Resource struct {
+		client *kea.Client
+	}
+
+	// remotePool4ResourceSchema describes the resource data model.
+	remotePool4ResourceSchema struct {
+		Hostname             types.String              `tfsdk:"hostname"`
+		RemoteType           types.String              `tfsdk:"remote_type"`
+		RemoteHost           types.String              `tfsdk:"remote_host"`
+		RemotePort           types.Int64               `tfsdk:"remote_port"`
+		Subnet               networkStringValue        `tfsdk:"subnet"`
+		Pool                 networkStringValue        `tfsdk:"pool"`
+		OptionData           []optionDataResourceModel `tfsdk:"option_data"`
+		ClientClass          types.String              `tfsdk:"client_class"`
+		RequireClientClasses types.List                `tfsdk:"require_client_classes"`
+		UserContext          jsonObjectValue           `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remotePool4Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_pool4_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remotePool4Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := pool4Attributes()
+	pool := attributes["pool"].(schema.StringAttribute)
+	pool.MarkdownDescription += ". Changing the pool forces a new pool to be created."
+	pool.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	attributes["pool"] = pool
+	attributes["hostname"] = schema.StringAttribute{
+		MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+		Required:            true,
+	}
+	attributes["subnet"] = schema.StringAttribute{
+		MarkdownDescription: "Prefix of the existing subnet4 to add the pool to. e.g. `192.168.230.0/24`",
+		Required:            true,
+		CustomType:          prefixType,
+		Validators:          []validator.String{ipv4PrefixValidator()},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote Pool4 resource, a single pool of an existing subnet4 in the configuration-backend. Kea has " +
+			"no command for a single pool, so the provider reads the subnet and writes it back with the pool added, replaced " +
+			"or removed, leaving its other pools and parameters as they are. Don't manage the same pool in the `pools` of a " +
+			"`kea_remote_subnet4_resource`. A parent subnet managed by `kea_remote_subnet4_resource` must set `merge = true`, " +
+			"otherwise it reads the pool as drift and removes it on its next apply.",
+		Attributes: remoteAttributes(attributes),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remotePool4Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remotePool4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remotePool4ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteSubnet4PoolSet", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	err := client.RemoteSubnet4PoolSet(config.Hostname.ValueString(), config.Subnet.ValueString(), pool4FromSchema(config.pool()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteSubnet4PoolSet",
+			fmt.Sprintf("Unable to add pool `%s` to subnet4 `%s` in Kea, got error: %s", config.Pool.ValueString(), config.Subnet.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Pool4 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remotePool4Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remotePool4ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteSubnet4GetByPrefix", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	subnet, err := client.RemoteSubnet4GetByPrefix(config.Hostname.ValueString(), config.Subnet.ValueString())
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"RemoteSubnet4GetByPrefix",
+			fmt.Sprintf("Unable to read subnet4 `%s`, got error: %s", config.Subnet.ValueString(), err),
+		)
+		return
+	}
+
+	// The pool or its subnet was removed outside of Terraform, or never existed when importing.
+	pool := subnet4Pool(subnet, config.Pool.ValueString())
+	if pool == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	config.setPool(pool4ToSchema([]remoteSubnet4PoolResourceModel{config.pool()}, *pool, &resp.Diagnostics))
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *remotePool4Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remotePool4ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteSubnet4PoolSet", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	err := client.RemoteSubnet4PoolSet(config.Hostname.ValueString(), config.Subnet.ValueString(), pool4FromSchema(config.pool()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteSubnet4PoolSet",
+			fmt.Sprintf("Unable to update pool `%s` of subnet4 `%s` in Kea, got error: %s", config.Pool.ValueString(), config.Subnet.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Pool4 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remotePool4Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remotePool4ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteSubnet4PoolDel", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A pool whose subnet is already gone needs no deleting.
+	// nolint: contextcheck
+	err := client.RemoteSubnet4PoolDel(config.Hostname.ValueString(), config.Subnet.ValueString(), config.Pool.ValueString())
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"RemoteSubnet4PoolDel",
+			fmt.Sprintf("Unable to delete pool `%s` of subnet4 `%s`, got error: %s", config.Pool.ValueString(), config.Subnet.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Pool4 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/prefix/pool`, e.g. `kea.example.com/192.168.230.0/24/192.168.230.10-192.168.230.200`.
+func (r *remotePool4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, prefix, pool, err := parsePool4ImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet"), prefix)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool"), pool)...)
+}
+
+// ModifyPlan : Fills in the option_data codes and names from the option definitions, and validates the
+// data of custom options against their option-def in Kea.
+func (r *remotePool4Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	planOptionData4(ctx, planRemoteClient(ctx, r.client, req.Plan, &resp.Diagnostics), req, resp)
+}
+
+// ValidateConfig : Validates that the pool is inside the subnet prefix, that option-data codes are unique
+// and that standard options carry valid data.
+func (r *remotePool4Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remotePool4ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, &resp.Diagnostics)
+	optionDataResolve(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, standardOptionDef4, &resp.Diagnostics)
+
+	if config.Pool.IsNull() || config.Pool.IsUnknown() || config.Subnet.IsNull() || config.Subnet.IsUnknown() {
+		return
+	}
+	start, end, err := parsePool(config.Pool.ValueString())
+	if err == nil && validateIPv4Prefix(config.Subnet.ValueString()) == nil && !poolInPrefix(start, end, config.Subnet.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pool"),
+			"Pool Outside Subnet",
+			fmt.Sprintf("Pool `%s` is not inside the subnet `%s`.", config.Pool.ValueString(), config.Subnet.ValueString()),
+		)
+	}
+}
+
+// pool : Returns the pool attributes of the resource model.
+func (m remotePool4ResourceSchema) pool() remoteSubnet4PoolResourceModel {
+	return remoteSubnet4PoolResourceModel{
+		Pool: m.Pool, OptionData: m.OptionData, ClientClass: m.ClientClass,
+		RequireClientClasses: m.RequireClientClasses, UserContext: m.UserContext,
+	}
+}
+
+// setPool : Writes the pool attributes into the resource model.
+func (m *remotePool4ResourceSchema) setPool(p remoteSubnet4PoolResourceModel) {
+	m.Pool, m.OptionData, m.ClientClass = p.Pool, p.OptionData, p.ClientClass
+	m.RequireClientClasses, m.UserContext = p.RequireClientClasses, p.UserContext
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestPool4SchemaRoundTrip(t *testing.T) {
+	code := 6
+	space := kea.DHCP4OptionSpace
+	want := []kea.Pool{
+		{
+			Pool:                 "192.168.230.10-192.168.230.100",
+			OptionData:           []kea.OptionData{{Code: &code, Name: "domain-name-servers", Data: "192.168.230.53", Space: &space}},
+			ClientClass:          "voip",
+			RequireClientClasses: []string{"phones"},
+			UserContext:          map[string]any{"vlan": "230"},
+		},
+		{Pool: "192.168.230.128/26", OptionData: []kea.OptionData{}},
+	}
+
+	var diags diag.Diagnostics
+	pools := pool4sToSchema(nil, want, &diags)
+	if diags.HasError() {
+		t.Fatalf("pool4sToSchema() diagnostics: %v", diags)
+	}
+	if !pools[1].RequireClientClasses.IsNull() || !pools[1].UserContext.IsNull() || pools[1].OptionData != nil {
+		t.Errorf("pool4sToSchema() did not keep the unset attributes of %s null: %+v", want[1].Pool, pools[1])
+	}
+
+	// A prior pool with the same range keeps its empty option_data.
+	pools[1].OptionData = []optionDataResourceModel{}
+	pools = pool4sToSchema(pools, want, &diags)
+
+	got := pool4sFromSchema(pools)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pools did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+
+	// A prior pool written in another notation of the same range keeps it.
+	pools[0].Pool = poolType.value("192.168.230.10 - 192.168.230.100")
+	pools = pool4sToSchema(pools, want, &diags)
+	if pools[0].Pool.ValueString() != "192.168.230.10 - 192.168.230.100" {
+		t.Errorf("pool4sToSchema() replaced the prior notation with %s", pools[0].Pool.ValueString())
+	}
+}
+
+func TestSubnet4Pool(t *testing.T) {
+	subnet := kea.RemoteSubnet4{Pools: []kea.Pool{{Pool: "192.168.230.10-192.168.230.100"}, {Pool: "192.168.230.128/26"}}}
+	if p := subnet4Pool(subnet, "192.168.230.10 - 192.168.230.100"); p == nil || p.Pool != subnet.Pools[0].Pool {
+		t.Errorf("subnet4Pool() = %+v, want the first pool", p)
+	}
+	if p := subnet4Pool(subnet, "192.168.230.200-192.168.230.210"); p != nil {
+		t.Errorf("subnet4Pool() = %+v, want nil", p)
+	}
+}
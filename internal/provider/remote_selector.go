@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+const (
+	remoteTypeDescription = "Type of the configuration-backend, `mysql` or `postgresql`."
+	remoteHostDescription = "Host of the configuration-backend database, to tell apart several backends of the same type."
+	remotePortDescription = "Port of the configuration-backend database, to tell apart several backends on the same host."
+
+	// remoteReplaceDescription : Completes the description of the remote attributes of a resource.
+	remoteReplaceDescription = " Changing it replaces the resource. Imports use the remote of the provider, so import " +
+		"from another backend through a provider alias."
+)
+
+// remoteAttributes : Adds the `remote_type`, `remote_host` and `remote_port` attributes to a resource schema,
+// they override the configuration-backend remote of the provider for the resource. The remote decides which
+// backend holds the object, so changing it replaces the resource. Import IDs carry no remote, so imports use
+// the remote of the provider.
+func remoteAttributes(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["remote_type"] = schema.StringAttribute{
+		MarkdownDescription: remoteTypeDescription + " Overrides the provider `remote_type`." + remoteReplaceDescription,
+		Optional:            true,
+		Validators:          []validator.String{oneOfValidator(kea.RemoteTypes...)},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["remote_host"] = schema.StringAttribute{
+		MarkdownDescription: remoteHostDescription + " Overrides the provider `remote_host`." + remoteReplaceDescription,
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["remote_port"] = schema.Int64Attribute{
+		MarkdownDescription: remotePortDescription + " Overrides the provider `remote_port`." + remoteReplaceDescription,
+		Optional:            true,
+		Validators:          []validator.Int64{portValidator()},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+	return attrs
+}
+
+// remoteDataSourceAttributes : Adds the `remote_type`, `remote_host` and `remote_port` attributes to a data
+// source schema, they override the configuration-backend remote of the provider for the data source.
+func remoteDataSourceAttributes(attrs map[string]dsschema.Attribute) map[string]dsschema.Attribute {
+	attrs["remote_type"] = dsschema.StringAttribute{
+		MarkdownDescription: remoteTypeDescription + " Overrides the provider `remote_type`.",
+		Optional:            true,
+		Validators:          []validator.String{oneOfValidator(kea.RemoteTypes...)},
+	}
+	attrs["remote_host"] = dsschema.StringAttribute{
+		MarkdownDescription: remoteHostDescription + " Overrides the provider `remote_host`.",
+		Optional:            true,
+	}
+	attrs["remote_port"] = dsschema.Int64Attribute{
+		MarkdownDescription: remotePortDescription + " Overrides the provider `remote_port`.",
+		Optional:            true,
+		Validators:          []validator.Int64{portValidator()},
+	}
+	return attrs
+}
+
+// remoteSelector : Builds the remote selector from the remote attributes, null attributes are left empty.
+func remoteSelector(remoteType, host types.String, port types.Int64) kea.Remote {
+	return kea.Remote{Type: remoteType.ValueString(), Host: host.ValueString(), Port: int(port.ValueInt64())}
+}
+
+// remoteClient : Returns the client sending the remote selected by the remote attributes of a resource or data
+// source, falling back to the remote of the provider for the null attributes.
+func remoteClient(client *kea.Client, remoteType, host types.String, port types.Int64) *kea.Client {
+	return client.ForRemote(remoteSelector(remoteType, host, port))
+}
+
+// planRemoteClient : Returns the client sending the remote selected by the planned resource, for the
+// configuration-backend lookups of ModifyPlan.
+func planRemoteClient(ctx context.Context, client *kea.Client, plan tfsdk.Plan, diags *diag.Diagnostics) *kea.Client {
+	var remoteType, host types.String
+	var port types.Int64
+	diags.Append(plan.GetAttribute(ctx, path.Root("remote_type"), &remoteType)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("remote_host"), &host)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("remote_port"), &port)...)
+	return remoteClient(client, remoteType, host, port)
+}
+
+// pullAfterApply : Asks the Kea servers to pick up a change from the configuration-backend, when the provider
+// is configured to. A failed pull is reported as a warning, as `what` was already applied to the backend.
+func pullAfterApply(client *kea.Client, hostname, service, what string, diags *diag.Diagnostics) {
+	if err := client.PullAfterApply(hostname, service); err != nil {
+		diags.AddWarning(
+			"ConfigBackendPull",
+			fmt.Sprintf("%s, but config-backend-pull failed: %s", what, err),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestRemoteSelector(t *testing.T) {
+	if got := remoteSelector(types.StringNull(), types.StringNull(), types.Int64Null()); got != (kea.Remote{}) {
+		t.Errorf("remoteSelector() of null attributes = %+v, want an empty selector", got)
+	}
+	got := remoteSelector(types.StringValue(kea.RemoteTypeMySQL), types.StringValue("kea-db.example.com"), types.Int64Value(3306))
+	want := kea.Remote{Type: kea.RemoteTypeMySQL, Host: "kea-db.example.com", Port: 3306}
+	if got != want {
+		t.Errorf("remoteSelector() = %+v, want %+v", got, want)
+	}
+}
+
+// TestRemoteAttributes : Every configuration-backend resource and data source can override the provider remote.
+func TestRemoteAttributes(t *testing.T) {
+	ctx := context.Background()
+	p := &KeaCBProvider{}
+
+	for _, fn := range p.Resources(ctx) {
+		r := fn()
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "kea"}, &meta)
+		if !strings.HasPrefix(meta.TypeName, "kea_remote_") {
+			continue
+		}
+		var s resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &s)
+		for _, name := range []string{"remote_type", "remote_host", "remote_port"} {
+			a, ok := s.Schema.Attributes[name]
+			if !ok {
+				t.Errorf("%s has no %s attribute", meta.TypeName, name)
+				continue
+			}
+			// The remote selects the backend holding the object, so it can't change in place.
+			var descriptions []string
+			switch a := a.(type) {
+			case rschema.StringAttribute:
+				for _, m := range a.PlanModifiers {
+					descriptions = append(descriptions, m.Description(ctx))
+				}
+			case rschema.Int64Attribute:
+				for _, m := range a.PlanModifiers {
+					descriptions = append(descriptions, m.Description(ctx))
+				}
+			}
+			if !slices.ContainsFunc(descriptions, func(d string) bool { return strings.Contains(d, "destroy and recreate") }) {
+				t.Errorf("%s %s does not require replacement", meta.TypeName, name)
+			}
+		}
+	}
+
+	for _, fn := range p.DataSources(ctx) {
+		d := fn()
+		var meta datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "kea"}, &meta)
+		if !strings.HasPrefix(meta.TypeName, "kea_remote_") {
+			continue
+		}
+		var s datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &s)
+		for _, name := range []string{"remote_type", "remote_host", "remote_port"} {
+			if _, ok := s.Schema.Attributes[name]; !ok {
+				t.Errorf("%s has no %s attribute", meta.TypeName, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &remoteSharedNetwork6Resource{}
+	_ resource.ResourceWithImportState    = &remoteSharedNetwork6Resource{}
+	_ resource.ResourceWithValidateConfig = &remoteSharedNetwork6Resource{}
+)
+
+// NewRemoteSharedNetwork6Resource : Creates a new empty resource client.
+func NewRemoteSharedNetwork6Resource() resource.Resource {
+	return &remoteSharedNetwork6Resource{}
+}
+
+type (
+	// remoteSharedNetwork6Resource defines the resource implementation.
+	remoteSharedNetwork6Resource struct {
+		client *kea.Client
+	}
+
+	// remoteSharedNetwork6ResourceSchema describes the resource data model.
+	remoteSharedNetwork6ResourceSchema struct {
+		Hostname             types.String                      `tfsdk:"hostname"`
+		RemoteType           types.String                      `tfsdk:"remote_type"`
+		RemoteHost           types.String                      `tfsdk:"remote_host"`
+		RemotePort           types.Int64                       `tfsdk:"remote_port"`
+		Name                 types.String                      `tfsdk:"name"`
+		Interface            types.String                      `tfsdk:"interface"`
+		Relay                []remoteSubnet4RelayResourceModel `tfsdk:"relay"`
+		OptionData           []optionDataResourceModel         `tfsdk:"option_data"`
+		ClientClass          types.String                      `tfsdk:"client_class"`
+		RequireClientClasses types.List                        `tfsdk:"require_client_classes"`
+		RapidCommit          types.Bool                        `tfsdk:"rapid_commit"`
+		PreferredLifetime    types.Int64                       `tfsdk:"preferred_lifetime"`
+		ValidLifetime        types.Int64                       `tfsdk:"valid_lifetime"`
+		RenewTimer           types.Int64                       `tfsdk:"renew_timer"`
+		RebindTimer          types.Int64                       `tfsdk:"rebind_timer"`
+		UserContext          jsonObjectValue                   `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *remoteSharedNetwork6Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_shared_network6_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *remoteSharedNetwork6Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Remote SharedNetwork6 resource, a dhcp6 shared-network in the configuration-backend. Subnets join " +
+			"the shared-network by name, and are kept when the shared-network is destroyed. The resource owns the whole " +
+			"`option_data` of the shared-network, so don't combine it with `kea_remote_option6_network_resource`.",
+		Attributes: remoteAttributes(map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the shared-network. e.g. `office`. Changing the name forces a new shared-network to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the shared-network is directly reachable on. e.g. `eth0`",
+				Optional:            true,
+			},
+			"relay": schema.SetNestedAttribute{
+				MarkdownDescription: "List of relay IPs to configure in Kea. e.g. `['2001:db8::1']`",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{Required: true, CustomType: ipAddressType, Validators: []validator.String{ipv6AddressValidator()}},
+					},
+				},
+			},
+			"option_data": optionDataAttribute(kea.DHCP6OptionSpace),
+			"client_class": schema.StringAttribute{
+				MarkdownDescription: "Only serve clients of this class from the shared-network. e.g. `voip`",
+				Optional:            true,
+			},
+			"require_client_classes": schema.ListAttribute{
+				MarkdownDescription: "Classes with `only_if_required` to evaluate for clients of the shared-network. e.g. `[\"voip\"]`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"rapid_commit": schema.BoolAttribute{
+				MarkdownDescription: "Allow the two message exchange of rapid commit (option 14).",
+				Optional:            true,
+			},
+			"preferred_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Preferred lifetime of the leases in seconds. e.g. `3000`. Unset inherits the global value.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Valid lifetime of the leases in seconds. e.g. `4000`. Unset inherits the global value.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"renew_timer": schema.Int64Attribute{
+				MarkdownDescription: "T1, the time in seconds after which the client renews its lease.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"rebind_timer": schema.Int64Attribute{
+				MarkdownDescription: "T2, the time in seconds after which the client rebinds its lease.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"user_context": userContextAttribute("shared-network"),
+		}),
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *remoteSharedNetwork6Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *remoteSharedNetwork6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config remoteSharedNetwork6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteNetwork6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteNetwork6Set(config.Hostname.ValueString(), remoteNetwork6FromSchema(config)); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteNetwork6Set",
+			fmt.Sprintf("Unable to create shared-network6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "SharedNetwork6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *remoteSharedNetwork6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config remoteSharedNetwork6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteNetwork6Get", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := client.RemoteNetwork6Get(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil {
+		// The shared-network was removed outside of Terraform, or never existed when importing.
+		if errors.Is(err, kea.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"RemoteNetwork6Get",
+			fmt.Sprintf("Unable to read shared-network6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	remoteNetwork6ToSchema(&config, respData, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *remoteSharedNetwork6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config remoteSharedNetwork6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteNetwork6Set", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	if err := client.RemoteNetwork6Set(config.Hostname.ValueString(), remoteNetwork6FromSchema(config)); err != nil {
+		resp.Diagnostics.AddError(
+			"RemoteNetwork6Set",
+			fmt.Sprintf("Unable to update shared-network6 `%s` in Kea, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "SharedNetwork6 was written to the configuration-backend", &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *remoteSharedNetwork6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config remoteSharedNetwork6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("RemoteNetwork6Del", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A shared-network that is already gone needs no deleting.
+	// nolint: contextcheck
+	err := client.RemoteNetwork6Del(config.Hostname.ValueString(), config.Name.ValueString())
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"RemoteNetwork6Del",
+			fmt.Sprintf("Unable to delete shared-network6 `%s`, got error: %s", config.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp6", "SharedNetwork6 was deleted from the configuration-backend", &resp.Diagnostics)
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/name`, e.g. `kea.example.com/office`.
+func (r *remoteSharedNetwork6Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, name, err := parseNamedImportID(req.ID, "kea.example.com/office")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// ValidateConfig : Validates that option-data codes are unique.
+func (r *remoteSharedNetwork6Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remoteSharedNetwork6ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP6OptionSpace, &resp.Diagnostics)
+}
+
+// remoteNetwork6FromSchema : Converts the resource model into a Kea shared-network, leaving null
+// attributes unset so that they are inherited.
+func remoteNetwork6FromSchema(config remoteSharedNetwork6ResourceSchema) kea.RemoteNetwork6 {
+	relay := kea.Relay{}
+	for _, ip := range config.Relay {
+		relay.IPAddresses = append(relay.IPAddresses, ip.IPAddress.ValueString())
+	}
+	return kea.RemoteNetwork6{
+		Name:                 config.Name.ValueString(),
+		Interface:            config.Interface.ValueString(),
+		Relay:                relay,
+		OptionData:           optionDataFromSchema(config.OptionData),
+		ClientClass:          config.ClientClass.ValueString(),
+		RequireClientClasses: stringListElements(config.RequireClientClasses),
+		RapidCommit:          config.RapidCommit.ValueBoolPointer(),
+		PreferredLifetime:    intPointer(config.PreferredLifetime),
+		ValidLifetime:        intPointer(config.ValidLifetime),
+		RenewTimer:           intPointer(config.RenewTimer),
+		RebindTimer:          intPointer(config.RebindTimer),
+		UserContext:          userContextFromSchema(config.UserContext),
+	}
+}
+
+// remoteNetwork6ToSchema : Writes the shared-network read from Kea into the resource model.
+func remoteNetwork6ToSchema(config *remoteSharedNetwork6ResourceSchema, n kea.RemoteNetwork6, diags *diag.Diagnostics) {
+	config.Name = types.StringValue(n.Name)
+	config.Interface = stringValueOrNull(n.Interface)
+	if len(n.Relay.IPAddresses) > 0 || config.Relay != nil {
+		config.Relay = make([]remoteSubnet4RelayResourceModel, 0, len(n.Relay.IPAddresses))
+		for _, v := range n.Relay.IPAddresses {
+			config.Relay = append(config.Relay, remoteSubnet4RelayResourceModel{IPAddress: ipAddressType.value(v)})
+		}
+	}
+	config.OptionData = optionDataToSchema(config.OptionData, n.OptionData, kea.DHCP6OptionSpace)
+	config.ClientClass = stringValueOrNull(n.ClientClass)
+	config.RequireClientClasses = stringListValue(config.RequireClientClasses, n.RequireClientClasses, diags)
+	config.RapidCommit = types.BoolPointerValue(n.RapidCommit)
+	config.PreferredLifetime = int64PointerValue(n.PreferredLifetime)
+	config.ValidLifetime = int64PointerValue(n.ValidLifetime)
+	config.RenewTimer = int64PointerValue(n.RenewTimer)
+	config.RebindTimer = int64PointerValue(n.RebindTimer)
+	config.UserContext = userContextToSchema(config.UserContext, n.UserContext, diags)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestRemoteNetwork6SchemaRoundTrip(t *testing.T) {
+	code := 23
+	space := kea.DHCP6OptionSpace
+	rapidCommit := true
+	lifetime := 4000
+	want := kea.RemoteNetwork6{
+		Name:                 "office",
+		Interface:            "eth0",
+		Relay:                kea.Relay{IPAddresses: []string{"2001:db8::1"}},
+		OptionData:           []kea.OptionData{{Code: &code, Name: "dns-servers", Data: "2001:db8::53", Space: &space}},
+		ClientClass:          "voip",
+		RequireClientClasses: []string{"cpe"},
+		RapidCommit:          &rapidCommit,
+		ValidLifetime:        &lifetime,
+		UserContext:          map[string]any{"site": "AUS"},
+	}
+
+	var diags diag.Diagnostics
+	config := remoteSharedNetwork6ResourceSchema{
+		RequireClientClasses: types.ListNull(types.StringType),
+		UserContext:          jsonObjectValue{StringValue: types.StringNull()},
+	}
+	remoteNetwork6ToSchema(&config, want, &diags)
+	if diags.HasError() {
+		t.Fatalf("remoteNetwork6ToSchema() diagnostics: %v", diags)
+	}
+
+	got := remoteNetwork6FromSchema(config)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("shared-network6 did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+}
@@ -39,12 +39,15 @@ type (
 		Prefix      types.String                         `tfsdk:"prefix"`
 		SubnetID    types.Int64                          `tfsdk:"subnet_id"`
 		Hostname    types.String                         `tfsdk:"hostname"`
+		RemoteType  types.String                         `tfsdk:"remote_type"`
+		RemoteHost  types.String                         `tfsdk:"remote_host"`
+		RemotePort  types.Int64                          `tfsdk:"remote_port"`
 		ID          types.Int64                          `tfsdk:"id"`
 		OptionData  []remoteSubnet4DataSourceOptionModel `tfsdk:"option_data"`
 		Pools       types.List                           `tfsdk:"pools"`
 		Relay       types.List                           `tfsdk:"relay"`
 		Subnet      types.String                         `tfsdk:"subnet"`
-		UserContext types.Map                            `tfsdk:"user_context"`
+		UserContext jsonObjectValue                      `tfsdk:"user_context"`
 	}
 
 	// optionDataModel : Represents a single option-data entry in Kea.
@@ -66,7 +69,7 @@ func (d *remoteSubnet4DataSource) Schema(_ context.Context, _ datasource.SchemaR
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Remote subnet4 data source",
-		Attributes: map[string]schema.Attribute{
+		Attributes: remoteDataSourceAttributes(map[string]schema.Attribute{
 			"prefix": schema.StringAttribute{
 				MarkdownDescription: "Prefix to fetch from Kea configuration-backend. e.g. 192.168.230.0/24`",
 				Optional:            true,
@@ -95,12 +98,12 @@ func (d *remoteSubnet4DataSource) Schema(_ context.Context, _ datasource.SchemaR
 					},
 				},
 			},
-			"user_context": schema.MapAttribute{
-				MarkdownDescription: "Arbitrary string data to tie to the subnet. e.g. `{site = \"AUS\", name = \"Austin, Tx\"}`",
-				ElementType:         types.StringType,
-				Optional:            true,
+			"user_context": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary data tied to the subnet, as a JSON object.",
+				Computed:            true,
+				CustomType:          jsonObjectType{},
 			},
-		},
+		}),
 	}
 }
 
@@ -131,6 +134,7 @@ func (d *remoteSubnet4DataSource) Read(ctx context.Context, req datasource.ReadR
 	// Read Terraform configuration data into the model
 	// Also append any diagnostics to the diagnostics list.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	client := remoteClient(d.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	// Validate that only one of `prefix` or `subnet_id` is specified.
 	if (!config.Prefix.IsNull() && !config.SubnetID.IsNull()) || (config.Prefix.IsNull() && config.SubnetID.IsNull()) {
@@ -157,7 +161,7 @@ func (d *remoteSubnet4DataSource) Read(ctx context.Context, req datasource.ReadR
 	var err error
 	if !config.Prefix.IsNull() {
 		// nolint: contextcheck
-		respData, err = d.client.RemoteSubnet4GetByPrefix(config.Hostname.ValueString(), config.Prefix.ValueString())
+		respData, err = client.RemoteSubnet4GetByPrefix(config.Hostname.ValueString(), config.Prefix.ValueString())
 		if err != nil {
 			// Only return an error if the error is NOT subnet not found.
 			if !strings.Contains(err.Error(), "not found") {
@@ -170,7 +174,7 @@ func (d *remoteSubnet4DataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 	} else {
 		// nolint: contextcheck
-		respData, err = d.client.RemoteSubnet4GetByID(config.Hostname.ValueString(), int(config.SubnetID.ValueInt64()))
+		respData, err = client.RemoteSubnet4GetByID(config.Hostname.ValueString(), int(config.SubnetID.ValueInt64()))
 		if err != nil {
 			// Only return an error if the error is NOT subnet not found.
 			if !strings.Contains(err.Error(), "not found") {
@@ -227,17 +231,7 @@ func (d *remoteSubnet4DataSource) Read(ctx context.Context, req datasource.ReadR
 		return retVal
 	}()
 	config.Subnet = types.StringValue(respData.Subnet)
-	if respData.UserContext != nil {
-		config.UserContext = func() types.Map {
-			fr := make(map[string]attr.Value)
-			for k, v := range respData.UserContext {
-				fr[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-			mv, diags := types.MapValue(types.StringType, fr)
-			resp.Diagnostics.Append(diags...)
-			return mv
-		}()
-	}
+	config.UserContext = userContextToSchema(jsonObjectValue{StringValue: types.StringNull()}, respData.UserContext, &resp.Diagnostics)
 
 	// If there are any diagnostics errors, stop here.
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// subnet4ObjectKeys : Keys of a subnet JSON object that identify the subnet or that Kea maintains, which merge mode
+// never changes.
+var subnet4ObjectKeys = map[string]bool{"id": true, "subnet": true, "shared-network-name": true, "metadata": true}
+
+// subnet4ObjectEntries : Lists of a subnet JSON object that merge mode merges entry by entry, with the
+// function telling whether two entries are the same option or pool.
+var subnet4ObjectEntries = map[string]func(a, b map[string]any) bool{
+	"option-data": sameOptionObject,
+	"pools":       samePoolObject,
+}
+
+// subnet4ManagedObject : Returns the subnet JSON object of the attributes managed by the resource model, the
+// option-data including the options rendered from `classless_static_routes` and `vendor_options`. Null attributes
+// are left out, and so is `relay` when it is empty.
+func subnet4ManagedObject(config remoteSubnet4ResourceSchema) (map[string]any, error) {
+	structured, _, err := structuredOptionsFromSchema(config.ClasslessStaticRoutes, config.VendorOptions)
+	if err != nil {
+		return nil, err
+	}
+	subnet := remoteSubnet4FromSchema(config, 0)
+	subnet.OptionData = append(subnet.OptionData, structured...)
+
+	b, err := json.Marshal(subnet)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := decodeObject(b)
+	if err != nil {
+		return nil, err
+	}
+	for k := range subnet4ObjectKeys {
+		delete(obj, k)
+	}
+	if len(config.Relay) == 0 {
+		delete(obj, "relay")
+	}
+	return obj, nil
+}
+
+// mergeSubnet4Object : Applies the managed attributes of desired to the current subnet JSON object. Attributes
+// managed in prior but no longer in desired are removed. Option-data and pools are merged entry by entry, and
+// user-context key by key, so that unmanaged entries and keys are kept.
+func mergeSubnet4Object(current, prior, desired map[string]any) {
+	for k := range prior {
+		if _, ok := desired[k]; !ok && !subnet4ObjectKeys[k] && subnet4ObjectEntries[k] == nil && k != "user-context" {
+			delete(current, k)
+		}
+	}
+	for k, v := range desired {
+		if !subnet4ObjectKeys[k] && subnet4ObjectEntries[k] == nil && k != "user-context" {
+			current[k] = v
+		}
+	}
+
+	for k, same := range subnet4ObjectEntries {
+		current[k] = mergeEntries(objectList(current[k]), objectList(prior[k]), objectList(desired[k]), same)
+	}
+
+	userContext := make(map[string]any)
+	if v, ok := current["user-context"].(map[string]any); ok {
+		userContext = v
+	}
+	if v, ok := prior["user-context"].(map[string]any); ok {
+		for k := range v {
+			delete(userContext, k)
+		}
+	}
+	if v, ok := desired["user-context"].(map[string]any); ok {
+		for k, e := range v {
+			userContext[k] = e
+		}
+	}
+	if len(userContext) == 0 {
+		delete(current, "user-context")
+		return
+	}
+	current["user-context"] = userContext
+}
+
+// filterSubnet4Object : Limits the current subnet JSON object to the attributes managed in the managed object, so
+// that unmanaged option-data, pools, user-context keys and parameters are not reported as drift.
+func filterSubnet4Object(current, managed map[string]any) {
+	for k, v := range current {
+		switch {
+		case subnet4ObjectKeys[k]:
+		case subnet4ObjectEntries[k] != nil:
+			kept := make([]any, 0)
+			for _, e := range objectList(v) {
+				if findEntry(objectList(managed[k]), e, subnet4ObjectEntries[k]) >= 0 {
+					kept = append(kept, e)
+				}
+			}
+			current[k] = kept
+		case k == "user-context":
+			userContext, _ := v.(map[string]any)
+			keys, _ := managed[k].(map[string]any)
+			for uk := range userContext {
+				if _, ok := keys[uk]; !ok {
+					delete(userContext, uk)
+				}
+			}
+		default:
+			if _, ok := managed[k]; !ok {
+				delete(current, k)
+			}
+		}
+	}
+}
+
+// decodeSubnet4Object : Decodes a subnet JSON object into a Kea subnet.
+func decodeSubnet4Object(obj map[string]any) (kea.RemoteSubnet4, error) {
+	var ret kea.RemoteSubnet4
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return ret, err
+	}
+	err = json.Unmarshal(b, &ret)
+	return ret, err
+}
+
+// mergeEntries : Merges the desired entries into the current ones. Current entries matching a desired entry are
+// replaced in place, those only matching a prior entry are removed, and the other desired entries are appended.
+func mergeEntries(current, prior, desired []map[string]any, same func(a, b map[string]any) bool) []any {
+	ret := make([]any, 0, len(current)+len(desired))
+	used := make([]bool, len(desired))
+	for _, c := range current {
+		if i := findEntry(desired, c, same); i >= 0 {
+			if !used[i] {
+				ret = append(ret, desired[i])
+			}
+			used[i] = true
+			continue
+		}
+		if findEntry(prior, c, same) < 0 {
+			ret = append(ret, c)
+		}
+	}
+	for i, d := range desired {
+		if !used[i] {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+// findEntry : Returns the index of the entry of list that is the same as e, or -1.
+func findEntry(list []map[string]any, e any, same func(a, b map[string]any) bool) int {
+	m, ok := e.(map[string]any)
+	if !ok {
+		return -1
+	}
+	for i, v := range list {
+		if same(v, m) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameOptionObject : Reports whether two option-data objects are the same option, by their space and their
+// code, or their name when either has no code.
+func sameOptionObject(a, b map[string]any) bool {
+	space := func(o map[string]any) string {
+		if s, ok := o["space"].(string); ok && s != "" {
+			return s
+		}
+		return kea.DHCP4OptionSpace
+	}
+	if space(a) != space(b) {
+		return false
+	}
+	if ca, cb := a["code"], b["code"]; ca != nil && cb != nil {
+		return fmt.Sprint(ca) == fmt.Sprint(cb)
+	}
+	na, _ := a["name"].(string)
+	nb, _ := b["name"].(string)
+	return na != "" && na == nb
+}
+
+// samePoolObject : Reports whether two pool objects cover the same range.
+func samePoolObject(a, b map[string]any) bool {
+	pa, _ := a["pool"].(string)
+	pb, _ := b["pool"].(string)
+	return normalizeNetworkValue(networkKindPool, pa) == normalizeNetworkValue(networkKindPool, pb)
+}
+
+// objectList : Returns the objects of a JSON list, skipping anything that is not an object.
+func objectList(v any) []map[string]any {
+	list, _ := v.([]any)
+	ret := make([]map[string]any, 0, len(list))
+	for _, e := range list {
+		if m, ok := e.(map[string]any); ok {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
+
+// decodeObject : Decodes a JSON object, numbers are kept as json.Number like in the objects read from Kea.
+func decodeObject(b []byte) (map[string]any, error) {
+	var ret map[string]any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
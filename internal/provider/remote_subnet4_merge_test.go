@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func mustObject(t *testing.T, s string) map[string]any {
+	t.Helper()
+	obj, err := decodeObject([]byte(s))
+	if err != nil {
+		t.Fatalf("decodeObject(%s): %s", s, err)
+	}
+	return obj
+}
+
+func TestMergeSubnet4Object(t *testing.T) {
+	current := mustObject(t, `{
+		"id": 7, "subnet": "192.168.230.0/24", "metadata": {"server-tags": ["all"]},
+		"valid-lifetime": 600, "renew-timer": 100, "boot-file-name": "pxelinux.0",
+		"option-data": [
+			{"code": 3, "name": "routers", "data": "192.168.230.1", "space": "dhcp4"},
+			{"code": 6, "name": "domain-name-servers", "data": "192.168.230.53", "space": "dhcp4"},
+			{"code": 15, "name": "domain-name", "data": "old.example.com", "space": "dhcp4"}
+		],
+		"pools": [{"pool": "192.168.230.10-192.168.230.100"}, {"pool": "192.168.230.150 - 192.168.230.160"}],
+		"user-context": {"owner": "netops", "site": "old", "legacy": true}
+	}`)
+	prior := mustObject(t, `{
+		"renew-timer": 100,
+		"option-data": [{"code": 15, "name": "domain-name", "data": "old.example.com"}],
+		"pools": [{"pool": "192.168.230.150-192.168.230.160"}],
+		"user-context": {"site": "old", "legacy": true}
+	}`)
+	desired := mustObject(t, `{
+		"valid-lifetime": 3600,
+		"option-data": [
+			{"code": 6, "name": "domain-name-servers", "data": "192.168.230.54", "space": "dhcp4"},
+			{"name": "ntp-servers", "data": "192.168.230.123"}
+		],
+		"pools": [{"pool": "192.168.230.200-192.168.230.210", "client-class": "voip"}],
+		"user-context": {"site": "lab"}
+	}`)
+
+	mergeSubnet4Object(current, prior, desired)
+
+	want := mustObject(t, `{
+		"id": 7, "subnet": "192.168.230.0/24", "metadata": {"server-tags": ["all"]},
+		"valid-lifetime": 3600, "boot-file-name": "pxelinux.0",
+		"option-data": [
+			{"code": 3, "name": "routers", "data": "192.168.230.1", "space": "dhcp4"},
+			{"code": 6, "name": "domain-name-servers", "data": "192.168.230.54", "space": "dhcp4"},
+			{"name": "ntp-servers", "data": "192.168.230.123"}
+		],
+		"pools": [{"pool": "192.168.230.10-192.168.230.100"}, {"pool": "192.168.230.200-192.168.230.210", "client-class": "voip"}],
+		"user-context": {"owner": "netops", "site": "lab"}
+	}`)
+	if !reflect.DeepEqual(roundTrip(t, current), roundTrip(t, want)) {
+		got, _ := json.Marshal(current)
+		t.Errorf("mergeSubnet4Object() =\n%s", got)
+	}
+
+	// Removing every managed attribute keeps only the unmanaged ones.
+	mergeSubnet4Object(current, desired, map[string]any{})
+	if _, ok := current["valid-lifetime"]; ok {
+		t.Errorf("mergeSubnet4Object() kept the removed valid-lifetime")
+	}
+	if got := len(objectList(current["option-data"])); got != 1 {
+		t.Errorf("mergeSubnet4Object() kept %d options, want the unmanaged routers only", got)
+	}
+	if got := current["user-context"]; !reflect.DeepEqual(got, map[string]any{"owner": "netops"}) {
+		t.Errorf("mergeSubnet4Object() user-context = %v, want the unmanaged owner only", got)
+	}
+}
+
+func TestFilterSubnet4Object(t *testing.T) {
+	current := mustObject(t, `{
+		"id": 7, "subnet": "192.168.230.0/24", "valid-lifetime": 600, "renew-timer": 100,
+		"option-data": [{"code": 3, "name": "routers", "data": "192.168.230.1"}, {"code": 6, "data": "192.168.230.53"}],
+		"pools": [{"pool": "192.168.230.10-192.168.230.100"}, {"pool": "192.168.230.150-192.168.230.160"}],
+		"user-context": {"owner": "netops", "site": "lab"}
+	}`)
+	managed := mustObject(t, `{
+		"valid-lifetime": 3600,
+		"option-data": [{"code": 6, "name": "domain-name-servers", "data": "192.168.230.54"}],
+		"pools": [{"pool": "192.168.230.150 - 192.168.230.160"}],
+		"user-context": {"site": "lab"}
+	}`)
+
+	filterSubnet4Object(current, managed)
+
+	want := mustObject(t, `{
+		"id": 7, "subnet": "192.168.230.0/24", "valid-lifetime": 600,
+		"option-data": [{"code": 6, "data": "192.168.230.53"}],
+		"pools": [{"pool": "192.168.230.150-192.168.230.160"}],
+		"user-context": {"site": "lab"}
+	}`)
+	if !reflect.DeepEqual(roundTrip(t, current), roundTrip(t, want)) {
+		got, _ := json.Marshal(current)
+		t.Errorf("filterSubnet4Object() =\n%s", got)
+	}
+
+	subnet, err := decodeSubnet4Object(current)
+	if err != nil {
+		t.Fatalf("decodeSubnet4Object(): %s", err)
+	}
+	if subnet.ID != 7 || len(subnet.Pools) != 1 || subnet.ValidLifetime == nil || *subnet.ValidLifetime != 600 {
+		t.Errorf("decodeSubnet4Object() = %+v", subnet)
+	}
+}
+
+func TestSameOptionObject(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"code": 6}`, `{"code": 6, "space": "dhcp4"}`, true},
+		{`{"code": 6}`, `{"code": 6, "space": "vendor-4491"}`, false},
+		{`{"code": 6, "name": "a"}`, `{"code": 15, "name": "a"}`, false},
+		{`{"name": "ntp-servers"}`, `{"code": 42, "name": "ntp-servers"}`, true},
+		{`{"data": "x"}`, `{"data": "x"}`, false},
+	}
+	for _, c := range cases {
+		if got := sameOptionObject(mustObject(t, c.a), mustObject(t, c.b)); got != c.want {
+			t.Errorf("sameOptionObject(%s, %s) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+// roundTrip : Normalizes a JSON object for comparison.
+func roundTrip(t *testing.T, obj map[string]any) map[string]any {
+	t.Helper()
+	b, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %s", err)
+	}
+	return mustObject(t, string(b))
+}
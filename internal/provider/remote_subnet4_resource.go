@@ -1,15 +1,25 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
@@ -17,9 +27,12 @@ import (
 
 var (
 	// Ensure provider defined types fully satisfy framework interfaces.
-	_            resource.Resource                = &remoteSubnet4Resource{}
-	_            resource.ResourceWithImportState = &remoteSubnet4Resource{}
-	cidrToIDRepl                                  = strings.NewReplacer(".", "", "/", "", " ", "")
+	_            resource.Resource                   = &remoteSubnet4Resource{}
+	_            resource.ResourceWithImportState    = &remoteSubnet4Resource{}
+	_            resource.ResourceWithValidateConfig = &remoteSubnet4Resource{}
+	_            resource.ResourceWithUpgradeState   = &remoteSubnet4Resource{}
+	_            resource.ResourceWithModifyPlan     = &remoteSubnet4Resource{}
+	cidrToIDRepl                                     = strings.NewReplacer(".", "", "/", "", " ", "")
 )
 
 // NewRemoteSubnet4Resource : Creates a new empty resource client.
@@ -35,34 +48,70 @@ type (
 
 	// remoteSubnet4ResourceSchema describes the resource data model.
 	remoteSubnet4ResourceSchema struct {
-		Hostname       types.String                       `tfsdk:"hostname"`
-		ID             types.Int64                        `tfsdk:"id"`
-		OptionData     []remoteSubnet4OptionResourceModel `tfsdk:"option_data"`
-		Pools          []remoteSubnet4PoolResourceModel   `tfsdk:"pools"`
-		Relay          []remoteSubnet4RelayResourceModel  `tfsdk:"relay"`
-		Subnet         types.String                       `tfsdk:"subnet"`
-		NextServer     types.String                       `tfsdk:"next_server"`
-		ServerHostname types.String                       `tfsdk:"server_hostname"`
-		BootFileName   types.String                       `tfsdk:"boot_file_name"`
-		UserContext    types.Map                          `tfsdk:"user_context"`
-	}
-
-	// remoteSubnet4OptionResourceModel : Represents a single option-data entry in Kea.
-	remoteSubnet4OptionResourceModel struct {
-		Code       types.Int64  `tfsdk:"code"`
-		Data       types.String `tfsdk:"data"`
-		Name       types.String `tfsdk:"name"`
-		AlwaysSend types.Bool   `tfsdk:"always_send"`
+		Hostname       types.String                      `tfsdk:"hostname"`
+		RemoteType     types.String                      `tfsdk:"remote_type"`
+		RemoteHost     types.String                      `tfsdk:"remote_host"`
+		RemotePort     types.Int64                       `tfsdk:"remote_port"`
+		ID             types.Int64                       `tfsdk:"id"`
+		SubnetID       types.Int64                       `tfsdk:"subnet_id"`
+		SubnetIDStrat  types.String                      `tfsdk:"subnet_id_strategy"`
+		OptionData     []optionDataResourceModel         `tfsdk:"option_data"`
+		Pools          []remoteSubnet4PoolResourceModel  `tfsdk:"pools"`
+		Relay          []remoteSubnet4RelayResourceModel `tfsdk:"relay"`
+		Subnet         networkStringValue                `tfsdk:"subnet"`
+		NextServer     networkStringValue                `tfsdk:"next_server"`
+		ServerHostname types.String                      `tfsdk:"server_hostname"`
+		BootFileName   types.String                      `tfsdk:"boot_file_name"`
+		UserContext    jsonObjectValue                   `tfsdk:"user_context"`
+		Merge          types.Bool                        `tfsdk:"merge"`
+
+		ClasslessStaticRoutes []classlessStaticRouteResourceModel `tfsdk:"classless_static_routes"`
+		VendorOptions         []vendorOptionResourceModel         `tfsdk:"vendor_options"`
+
+		ValidLifetime         types.Int64   `tfsdk:"valid_lifetime"`
+		MinValidLifetime      types.Int64   `tfsdk:"min_valid_lifetime"`
+		MaxValidLifetime      types.Int64   `tfsdk:"max_valid_lifetime"`
+		RenewTimer            types.Int64   `tfsdk:"renew_timer"`
+		RebindTimer           types.Int64   `tfsdk:"rebind_timer"`
+		CalculateTeeTimes     types.Bool    `tfsdk:"calculate_tee_times"`
+		T1Percent             types.Float64 `tfsdk:"t1_percent"`
+		T2Percent             types.Float64 `tfsdk:"t2_percent"`
+		Authoritative         types.Bool    `tfsdk:"authoritative"`
+		MatchClientID         types.Bool    `tfsdk:"match_client_id"`
+		Interface             types.String  `tfsdk:"interface"`
+		ReservationsGlobal    types.Bool    `tfsdk:"reservations_global"`
+		ReservationsInSubnet  types.Bool    `tfsdk:"reservations_in_subnet"`
+		ReservationsOutOfPool types.Bool    `tfsdk:"reservations_out_of_pool"`
+		StoreExtendedInfo     types.Bool    `tfsdk:"store_extended_info"`
+		CacheThreshold        types.Float64 `tfsdk:"cache_threshold"`
+		CacheMaxAge           types.Int64   `tfsdk:"cache_max_age"`
+		OfferLifetime         types.Int64   `tfsdk:"offer_lifetime"`
+
+		DDNSSendUpdates            types.Bool   `tfsdk:"ddns_send_updates"`
+		DDNSOverrideClientUpdate   types.Bool   `tfsdk:"ddns_override_client_update"`
+		DDNSOverrideNoUpdate       types.Bool   `tfsdk:"ddns_override_no_update"`
+		DDNSReplaceClientName      types.String `tfsdk:"ddns_replace_client_name"`
+		DDNSGeneratedPrefix        types.String `tfsdk:"ddns_generated_prefix"`
+		DDNSQualifyingSuffix       types.String `tfsdk:"ddns_qualifying_suffix"`
+		DDNSUpdateOnRenew          types.Bool   `tfsdk:"ddns_update_on_renew"`
+		DDNSUseConflictResolution  types.Bool   `tfsdk:"ddns_use_conflict_resolution"`
+		DDNSConflictResolutionMode types.String `tfsdk:"ddns_conflict_resolution_mode"`
+		HostnameCharSet            types.String `tfsdk:"hostname_char_set"`
+		HostnameCharReplacement    types.String `tfsdk:"hostname_char_replacement"`
 	}
 
 	// remoteSubnet4PoolResourceModel : Represents a single pool entry in Kea.
 	remoteSubnet4PoolResourceModel struct {
-		Pool types.String `tfsdk:"pool"`
+		Pool                 networkStringValue        `tfsdk:"pool"`
+		OptionData           []optionDataResourceModel `tfsdk:"option_data"`
+		ClientClass          types.String              `tfsdk:"client_class"`
+		RequireClientClasses types.List                `tfsdk:"require_client_classes"`
+		UserContext          jsonObjectValue           `tfsdk:"user_context"`
 	}
 
 	// remoteSubnet4RelayResourceModel : Represents a single ip-address relay entry in Kea.
 	remoteSubnet4RelayResourceModel struct {
-		IPAddress types.String `tfsdk:"ip_address"`
+		IPAddress networkStringValue `tfsdk:"ip_address"`
 	}
 )
 
@@ -76,55 +125,78 @@ func (r *remoteSubnet4Resource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Remote Subnet4 resource",
+		// Version 1 models `pools`, `relay` and `option_data` as sets, version 2 `user_context` as a JSON
+		// object, see UpgradeState.
+		Version: 2,
 
-		Attributes: map[string]schema.Attribute{
+		Attributes: remoteAttributes(map[string]schema.Attribute{
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
 				Required:            true,
 			},
 			"id": schema.Int64Attribute{Computed: true},
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "Subnet4 ID to configure in Kea. Computed by `subnet_id_strategy` when not specified. " +
+					"Changing the ID forces a new subnet to be created.",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.Int64{subnetIDValidator()},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id_strategy": schema.StringAttribute{
+				MarkdownDescription: "How to allocate the subnet ID when `subnet_id` is not specified. One of `derived` " +
+					"(network address without dots, e.g. `192.168.230.0/24` => `1921682300`), `next_free` (lowest ID not used " +
+					"by any subnet in the configuration-backend) or `explicit` (require `subnet_id`). Defaults to `derived`, " +
+					"and to `explicit` when `subnet_id` is specified. Creation is refused when the ID already belongs to a " +
+					"different prefix.",
+				Optional: true,
+			},
 			"subnet": schema.StringAttribute{
 				MarkdownDescription: "Subnet4 prefix to configure in Kea. e.g. `192.168.230.0/24`",
 				Required:            true,
+				CustomType:          prefixType,
+				Validators:          []validator.String{ipv4PrefixValidator()},
 			},
-			"pools": schema.ListNestedAttribute{
-				MarkdownDescription: "List of pools to configure in the subnet. e.g. `['192.168.230.10-192.168.230.200']",
-				Required:            true,
+			"pools": schema.SetNestedAttribute{
+				MarkdownDescription: "List of pools to configure in the subnet, each with its own option-data and client classes. " +
+					"Unless `merge` is set, pools not listed here are removed, including those of a `kea_remote_pool4_resource`. " +
+					"e.g. `[{pool = \"192.168.230.10-192.168.230.200\"}]`",
+				Required: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"pool": schema.StringAttribute{Required: true},
-					},
+					Attributes: pool4Attributes(),
 				},
 			},
-			"relay": schema.ListNestedAttribute{
+			"relay": schema.SetNestedAttribute{
 				MarkdownDescription: "List of relay IPs to configure in Kea. e.g. `['192.168.230.1']`",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"ip_address": schema.StringAttribute{Required: true},
+						"ip_address": schema.StringAttribute{Required: true, CustomType: ipAddressType, Validators: []validator.String{ipv4AddressValidator()}},
 					},
 				},
 			},
-			"option_data": schema.ListNestedAttribute{
-				MarkdownDescription: "List of option-data to configure on the pool. e.g. `[{code = 6, name = \"domain-name-servers\", data = \"8.8.8.8, 4.2.2.2\"}]`",
-				Optional:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"code":        schema.Int64Attribute{Required: true},
-						"name":        schema.StringAttribute{Required: true},
-						"data":        schema.StringAttribute{Required: true},
-						"always_send": schema.BoolAttribute{Required: true},
-					},
-				},
-			},
-			"user_context": schema.MapAttribute{
-				MarkdownDescription: "Arbitrary string data to tie to the subnet. e.g. `{site = \"AUS\", name = \"Austin, Tx\"}`",
-				ElementType:         types.StringType,
-				Optional:            true,
+			"option_data":             optionDataAttribute(kea.DHCP4OptionSpace),
+			"classless_static_routes": classlessStaticRoutesAttribute(),
+			"vendor_options":          vendorOptionsAttribute(),
+			"user_context":            userContextAttribute("subnet"),
+			"merge": schema.BoolAttribute{
+				MarkdownDescription: "Merge the configured attributes into the subnet instead of replacing it. The subnet is read, " +
+					"only the attributes managed in the configuration are applied, and unmanaged option-data, pools, " +
+					"user-context keys and parameters are left as they are. An existing subnet with the same prefix is " +
+					"adopted on creation, and on destruction only the managed attributes are removed from it. A subnet " +
+					"that did not exist yet is created, and deleted on destruction. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"next_server": schema.StringAttribute{
 				MarkdownDescription: "Optional TFTP boot server IP address, packets sent in the `siaddr` field.",
 				Optional:            true,
+				CustomType:          ipAddressType,
+				Validators:          []validator.String{ipv4AddressValidator()},
 			},
 			"server_hostname": schema.StringAttribute{
 				MarkdownDescription: "Optional, conveys a server hostname, can be up to 64 bytes long, and is in the `sname` field.",
@@ -134,7 +206,138 @@ func (r *remoteSubnet4Resource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Optional conveys the boot configuration file, can be up to 128 bytes long, and is sent using the `file` field.",
 				Optional:            true,
 			},
-		},
+			"valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime of the leases in seconds. e.g. `86400`. Unset inherits the shared-network or global value.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"min_valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Shortest lease lifetime in seconds a client can request with option 51.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"max_valid_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Longest lease lifetime in seconds a client can request with option 51.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"renew_timer": schema.Int64Attribute{
+				MarkdownDescription: "T1, the time in seconds after which the client renews its lease (option 58).",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"rebind_timer": schema.Int64Attribute{
+				MarkdownDescription: "T2, the time in seconds after which the client rebinds its lease (option 59).",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"calculate_tee_times": schema.BoolAttribute{
+				MarkdownDescription: "Calculate T1 and T2 from the lease lifetime with `t1_percent` and `t2_percent`, when the timers are not set.",
+				Optional:            true,
+			},
+			"t1_percent": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of the lease lifetime used to calculate T1. e.g. `0.5`",
+				Optional:            true,
+				Validators:          []validator.Float64{fractionValidator()},
+			},
+			"t2_percent": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of the lease lifetime used to calculate T2, must be greater than `t1_percent`. e.g. `0.875`",
+				Optional:            true,
+				Validators:          []validator.Float64{fractionValidator()},
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Answer requests for unknown addresses with a DHCPNAK, rather than ignoring them.",
+				Optional:            true,
+			},
+			"match_client_id": schema.BoolAttribute{
+				MarkdownDescription: "Identify clients by their client-id (option 61) before their hw-address.",
+				Optional:            true,
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "Interface the subnet is directly reachable on. e.g. `eth0`",
+				Optional:            true,
+			},
+			"reservations_global": schema.BoolAttribute{
+				MarkdownDescription: "Look up global host reservations for clients in this subnet.",
+				Optional:            true,
+			},
+			"reservations_in_subnet": schema.BoolAttribute{
+				MarkdownDescription: "Look up host reservations of this subnet.",
+				Optional:            true,
+			},
+			"reservations_out_of_pool": schema.BoolAttribute{
+				MarkdownDescription: "Only reserve addresses outside of the pools, which lets Kea skip reservation lookups for pool addresses.",
+				Optional:            true,
+			},
+			"store_extended_info": schema.BoolAttribute{
+				MarkdownDescription: "Store relay information, such as option 82, in the lease user-context.",
+				Optional:            true,
+			},
+			"cache_threshold": schema.Float64Attribute{
+				MarkdownDescription: "Fraction of the lease lifetime during which a renewal reuses the existing lease. e.g. `0.25`",
+				Optional:            true,
+				Validators:          []validator.Float64{fractionValidator()},
+			},
+			"cache_max_age": schema.Int64Attribute{
+				MarkdownDescription: "Longest age in seconds of a lease that can be reused. e.g. `600`",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"offer_lifetime": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime in seconds of leases offered but not yet requested (Kea 2.6+). `0` disables temporary allocation.",
+				Optional:            true,
+				Validators:          []validator.Int64{uint32Validator()},
+			},
+			"ddns_send_updates": schema.BoolAttribute{
+				MarkdownDescription: "Send DNS updates for the leases of this subnet to kea-dhcp-ddns.",
+				Optional:            true,
+			},
+			"ddns_override_client_update": schema.BoolAttribute{
+				MarkdownDescription: "Do the forward DNS update even when the client asks to do it itself.",
+				Optional:            true,
+			},
+			"ddns_override_no_update": schema.BoolAttribute{
+				MarkdownDescription: "Do the DNS updates even when the client asks for no updates.",
+				Optional:            true,
+			},
+			"ddns_replace_client_name": schema.StringAttribute{
+				MarkdownDescription: "When to replace the name sent by the client with a generated one. One of `never`, `always`, " +
+					"`when-present` or `when-not-present`.",
+				Optional:   true,
+				Validators: []validator.String{oneOfValidator(kea.DDNSReplaceClientNameModes...)},
+			},
+			"ddns_generated_prefix": schema.StringAttribute{
+				MarkdownDescription: "Prefix of generated names, followed by the address. e.g. `myhost`",
+				Optional:            true,
+			},
+			"ddns_qualifying_suffix": schema.StringAttribute{
+				MarkdownDescription: "Suffix appended to partial names to make them fully qualified. e.g. `example.com.`",
+				Optional:            true,
+			},
+			"ddns_update_on_renew": schema.BoolAttribute{
+				MarkdownDescription: "Update DNS on every lease renewal, rather than only when the name or address changes.",
+				Optional:            true,
+			},
+			"ddns_use_conflict_resolution": schema.BoolAttribute{
+				MarkdownDescription: "Use RFC 4703 conflict resolution. Superseded by `ddns_conflict_resolution_mode` in Kea 2.5+, " +
+					"conflicts with it.",
+				Optional: true,
+			},
+			"ddns_conflict_resolution_mode": schema.StringAttribute{
+				MarkdownDescription: "DNS conflict resolution behavior (Kea 2.5+). One of `check-with-dhcid`, `no-check-with-dhcid`, " +
+					"`check-exists-with-dhcid` or `no-check-without-dhcid`.",
+				Optional:   true,
+				Validators: []validator.String{oneOfValidator(kea.DDNSConflictResolutionModes...)},
+			},
+			"hostname_char_set": schema.StringAttribute{
+				MarkdownDescription: "Regular expression of the characters to replace in client names. e.g. `[^A-Za-z0-9.-]`",
+				Optional:            true,
+			},
+			"hostname_char_replacement": schema.StringAttribute{
+				MarkdownDescription: "Replacement of the characters matched by `hostname_char_set`, empty removes them. e.g. `x`",
+				Optional:            true,
+			},
+		}),
 	}
 }
 
@@ -161,9 +364,10 @@ func (r *remoteSubnet4Resource) Configure(_ context.Context, req resource.Config
 func (r *remoteSubnet4Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var config remoteSubnet4ResourceSchema
 
-	// Read Terraform configuration data into the model
+	// Read Terraform plan data into the model, which holds the option_data defaults.
 	// Also append any diagnostics to the diagnostics list.
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	// If the subnet value is empty, add an error to the diagnostics.
 	if config.Subnet.IsNull() || config.Subnet.IsUnknown() || config.Subnet.ValueString() == "" {
@@ -180,70 +384,53 @@ func (r *remoteSubnet4Resource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// The option-defs of the vendor sub-options are written once, for both the merge and the create path.
+	structured, owned, ok := r.setStructuredOptions(config, nil, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// In merge mode, an existing subnet with the prefix is adopted rather than replaced.
+	if config.Merge.ValueBool() {
+		// nolint: contextcheck
+		id, err := r.merge(config, nil)
+		switch {
+		case err == nil:
+			config.ID = types.Int64Value(int64(id))
+			config.SubnetID = types.Int64Value(int64(id))
+			setOwnedVendorOptionDefs(ctx, resp.Private, owned, &resp.Diagnostics)
+			setSubnet4Adopted(ctx, resp.Private, true, &resp.Diagnostics)
+			r.saveApplied(ctx, config, &resp.State, &resp.Diagnostics)
+			tflog.Trace(ctx, "merged into an existing resource")
+			return
+		case !errors.Is(err, kea.ErrNotFound):
+			resp.Diagnostics.AddError(
+				"RemoteSubnet4Create",
+				fmt.Sprintf("Unable to merge into subnet4 `%s` in Kea, got error: %s", config.Subnet.ValueString(), err),
+			)
+			return
+		}
+	}
+
 	subnet := config.Subnet.ValueString()
-	id, err := strconv.Atoi(cidrToIDRepl.Replace(strings.Split(subnet, "/")[0]))
+	strategy, explicit := subnetIDStrategy(config)
+
+	// nolint: contextcheck
+	id, err := allocateSubnetID(client, config.Hostname.ValueString(), subnet, strategy, explicit)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id"),
 			"RemoteSubnet4Create",
-			fmt.Sprintf("Unable to parse subnet4 prefix `%s`, got error: %s", subnet, err),
+			fmt.Sprintf("Unable to allocate a subnet ID for `%s`, got error: %s", subnet, err),
 		)
 		return
 	}
 
-	newSubnet := kea.NewRemoteSubnet4{
-		ID:     id,
-		Subnet: config.Subnet.ValueString(),
-		Pools: func() []kea.Pool {
-			fr := make([]kea.Pool, 0)
-			for _, p := range config.Pools {
-				fr = append(fr, kea.Pool{Pool: p.Pool.ValueString()})
-			}
-			return fr
-		}(),
-		OptionData: func() []kea.OptionData {
-			fr := make([]kea.OptionData, 0)
-			for _, o := range config.OptionData {
-				code := int(o.Code.ValueInt64())
-				fr = append(fr, kea.OptionData{
-					Code:       &code,
-					Name:       o.Name.ValueString(),
-					Data:       o.Data.ValueString(),
-					AlwaysSend: o.AlwaysSend.ValueBool(),
-				})
-			}
-			return fr
-		}(),
-		Relay: func() kea.Relay {
-			fr := kea.Relay{}
-			if len(config.Relay) == 0 {
-				return fr
-			}
-			for _, ip := range config.Relay {
-				fr.IPAddresses = append(fr.IPAddresses, ip.IPAddress.ValueString())
-			}
-			return fr
-		}(),
-		UserContext: func() map[string]string {
-			fr := make(map[string]string)
-			for k, v := range config.UserContext.Elements() {
-				fr[k] = v.String()
-			}
-			return fr
-		}(),
-	}
-
-	if !config.NextServer.IsNull() && !config.NextServer.IsUnknown() && config.NextServer.ValueString() != "" {
-		newSubnet.NextServer = config.NextServer.ValueString()
-	}
-	if !config.ServerHostname.IsNull() && !config.ServerHostname.IsUnknown() && config.ServerHostname.ValueString() != "" {
-		newSubnet.ServerHostname = config.ServerHostname.ValueString()
-	}
-	if !config.BootFileName.IsNull() && !config.BootFileName.IsUnknown() && config.BootFileName.ValueString() != "" {
-		newSubnet.BootFileName = config.BootFileName.ValueString()
-	}
+	newSubnet := remoteSubnet4FromSchema(config, id)
+	newSubnet.OptionData = append(newSubnet.OptionData, structured...)
 
 	// nolint: contextcheck
-	respData, err := r.client.RemoteSubnet4Set(config.Hostname.ValueString(), []kea.NewRemoteSubnet4{newSubnet})
+	respData, err := client.RemoteSubnet4Set(config.Hostname.ValueString(), []kea.NewRemoteSubnet4{newSubnet})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"RemoteSubnet4Create",
@@ -263,14 +450,17 @@ func (r *remoteSubnet4Resource) Create(ctx context.Context, req resource.CreateR
 	// it into the TF Subnets model.
 	res := respData[0]
 	config.ID = types.Int64Value(int64(res.ID))
-	config.Subnet = types.StringValue(res.Subnet)
+	config.SubnetID = types.Int64Value(int64(res.ID))
+	config.Subnet = prefixType.value(res.Subnet)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	setOwnedVendorOptionDefs(ctx, resp.Private, owned, &resp.Diagnostics)
+	setSubnet4Adopted(ctx, resp.Private, false, &resp.Diagnostics)
+	r.saveApplied(ctx, config, &resp.State, &resp.Diagnostics)
 }
 
 // Read : Reads the resource data into the Terraform state.
@@ -295,16 +485,18 @@ func (r *remoteSubnet4Resource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// nolint: contextcheck
-	respData, err := r.client.RemoteSubnet4GetByPrefix(config.Hostname.ValueString(), config.Subnet.ValueString())
+	respData, err := r.get(config)
 	if err != nil {
-		// Only return an error if the error is NOT subnet not found.
-		if !strings.Contains(err.Error(), "not found") {
-			resp.Diagnostics.AddError(
-				"RemoteSubnet4GetByPrefix",
-				fmt.Sprintf("Unable to read example, got error: %s", err),
-			)
+		// The subnet was removed outside of Terraform, or never existed when importing.
+		if strings.Contains(err.Error(), "not found") {
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		resp.Diagnostics.AddError(
+			"RemoteSubnet4GetByPrefix",
+			fmt.Sprintf("Unable to read example, got error: %s", err),
+		)
+		return
 	}
 
 	// If there are any diagnostics errors, stop here.
@@ -315,47 +507,36 @@ func (r *remoteSubnet4Resource) Read(ctx context.Context, req resource.ReadReque
 	// Marshalling the response data taken from Kea, and write
 	// it into the TF Subnets model.
 	config.ID = types.Int64Value(int64(respData.ID))
-	config.OptionData = func() []remoteSubnet4OptionResourceModel {
-		r := make([]remoteSubnet4OptionResourceModel, 0)
-		for _, v := range respData.OptionData {
-			code := 0
-			if v.Code != nil {
-				code = *v.Code
-			}
-			r = append(r, remoteSubnet4OptionResourceModel{
-				Code:       types.Int64Value(int64(code)),
-				Data:       types.StringValue(v.Data),
-				Name:       types.StringValue(v.Name),
-				AlwaysSend: types.BoolValue(v.AlwaysSend),
-			})
-		}
-		return r
-	}()
-	config.Pools = func() []remoteSubnet4PoolResourceModel {
-		fr := make([]remoteSubnet4PoolResourceModel, 0)
-		for _, v := range respData.Pools {
-			fr = append(fr, remoteSubnet4PoolResourceModel{Pool: types.StringValue(v.Pool)})
-		}
-		return fr
-	}()
+	config.SubnetID = types.Int64Value(int64(respData.ID))
+	opts := structuredOptionsToSchema(&config.ClasslessStaticRoutes, &config.VendorOptions, respData.OptionData, &resp.Diagnostics)
+	config.OptionData = optionDataToSchema(config.OptionData, opts, kea.DHCP4OptionSpace)
+	config.Pools = pool4sToSchema(config.Pools, respData.Pools, &resp.Diagnostics)
 	config.Relay = func() []remoteSubnet4RelayResourceModel {
 		fr := make([]remoteSubnet4RelayResourceModel, 0)
 		for _, v := range respData.Relay.IPAddresses {
-			fr = append(fr, remoteSubnet4RelayResourceModel{IPAddress: types.StringValue(v)})
+			relay := remoteSubnet4RelayResourceModel{IPAddress: ipAddressType.value(v)}
+			// A prior relay with the same address keeps its notation.
+			for _, p := range config.Relay {
+				if normalizeNetworkValue(networkKindIPAddress, p.IPAddress.ValueString()) == normalizeNetworkValue(networkKindIPAddress, v) {
+					relay = p
+					break
+				}
+			}
+			fr = append(fr, relay)
 		}
 		return fr
 	}()
-	config.Subnet = types.StringValue(respData.Subnet)
-	if respData.UserContext != nil {
-		config.UserContext = func() types.Map {
-			fr := make(map[string]attr.Value)
-			for k, v := range respData.UserContext {
-				fr[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-			mv, diags := types.MapValue(types.StringType, fr)
-			resp.Diagnostics.Append(diags...)
-			return mv
-		}()
+	config.Subnet = prefixType.value(respData.Subnet)
+	config.NextServer = ipAddressType.valueOrNull(respData.NextServer)
+	if respData.NextServer == "0.0.0.0" {
+		config.NextServer = ipAddressType.valueOrNull("")
+	}
+	config.ServerHostname = stringValueOrNull(respData.ServerHostname)
+	config.BootFileName = stringValueOrNull(respData.BootFileName)
+	subnet4ParametersToSchema(&config, respData.Subnet4Parameters)
+	config.UserContext = userContextToSchema(config.UserContext, respData.UserContext, &resp.Diagnostics)
+	if config.Merge.IsNull() {
+		config.Merge = types.BoolValue(false)
 	}
 
 	// If there are any diagnostics errors, stop here.
@@ -373,6 +554,7 @@ func (r *remoteSubnet4Resource) Update(ctx context.Context, req resource.UpdateR
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	// If the subnet value is empty, add an error to the diagnostics.
 	if config.Subnet.IsNull() || config.Subnet.IsUnknown() || config.Subnet.ValueString() == "" {
@@ -389,68 +571,70 @@ func (r *remoteSubnet4Resource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	subnet := config.Subnet.ValueString()
-	id, err := strconv.Atoi(cidrToIDRepl.Replace(strings.Split(subnet, "/")[0]))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"RemoteSubnet4Create",
-			fmt.Sprintf("Unable to parse subnet4 prefix `%s`, got error: %s", subnet, err),
-		)
+	// The subnet ID never changes in place, a new ID forces replacement. Keep the ID
+	// of the existing subnet, falling back to `id` for states without `subnet_id`.
+	var state remoteSubnet4ResourceSchema
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	update := kea.NewRemoteSubnet4{
-		ID:     id,
-		Subnet: config.Subnet.ValueString(),
-		Pools: func() []kea.Pool {
-			fr := make([]kea.Pool, 0)
-			for _, p := range config.Pools {
-				fr = append(fr, kea.Pool{Pool: p.Pool.ValueString()})
-			}
-			return fr
-		}(),
-		OptionData: func() []kea.OptionData {
-			fr := make([]kea.OptionData, 0)
-			for _, o := range config.OptionData {
-				code := int(o.Code.ValueInt64())
-				fr = append(fr, kea.OptionData{
-					Code:       &code,
-					Name:       o.Name.ValueString(),
-					Data:       o.Data.ValueString(),
-					AlwaysSend: o.AlwaysSend.ValueBool(),
-				})
-			}
-			return fr
-		}(),
-		Relay: func() kea.Relay {
-			fr := kea.Relay{}
-			if len(config.Relay) == 0 {
-				return fr
-			}
-			for _, ip := range config.Relay {
-				fr.IPAddresses = append(fr.IPAddresses, ip.IPAddress.ValueString())
-			}
-			return fr
-		}(),
-		UserContext: func() map[string]string {
-			elements := make(map[string]string, len(config.UserContext.Elements()))
-			diags := config.UserContext.ElementsAs(ctx, &elements, false)
-			resp.Diagnostics.Append(diags...)
-			return elements
-		}(),
+	id := int(state.ID.ValueInt64())
+	if !config.SubnetID.IsNull() && !config.SubnetID.IsUnknown() {
+		id = int(config.SubnetID.ValueInt64())
 	}
 
-	if !config.NextServer.IsNull() && !config.NextServer.IsUnknown() && config.NextServer.ValueString() != "" {
-		update.NextServer = config.NextServer.ValueString()
+	prevOwned := ownedVendorOptionDefs(ctx, req.Private, &resp.Diagnostics)
+
+	// In merge mode, only the attributes managed in the prior state or the plan are changed.
+	if config.Merge.ValueBool() {
+		_, owned, ok := r.setStructuredOptions(config, prevOwned, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		prior, err := subnet4ManagedObject(state)
+		if err != nil {
+			resp.Diagnostics.AddError("RemoteSubnet4Update", fmt.Sprintf("Unable to render the prior subnet4, got error: %s", err))
+			return
+		}
+		// nolint: contextcheck
+		if id, err = r.merge(config, prior); err != nil {
+			resp.Diagnostics.AddError(
+				"RemoteSubnet4Update",
+				fmt.Sprintf("Unable to merge into subnet4 in Kea, got error: %s", err),
+			)
+			return
+		}
+		config.ID = types.Int64Value(int64(id))
+		config.SubnetID = types.Int64Value(int64(id))
+		owned = append(owned, r.delVendorOptionDefs(config, staleVendorOptionDefs(prevOwned, owned), &resp.Diagnostics)...)
+		setOwnedVendorOptionDefs(ctx, resp.Private, owned, &resp.Diagnostics)
+		r.saveApplied(ctx, config, &resp.State, &resp.Diagnostics)
+		return
 	}
-	if !config.ServerHostname.IsNull() && !config.ServerHostname.IsUnknown() && config.ServerHostname.ValueString() != "" {
-		update.ServerHostname = config.ServerHostname.ValueString()
+
+	// A new prefix keeps the subnet ID, which must not belong to another subnet.
+	if !kea.SamePrefix(state.Subnet.ValueString(), config.Subnet.ValueString()) {
+		// nolint: contextcheck
+		if err := checkSubnetID(client, config.Hostname.ValueString(), config.Subnet.ValueString(), id, state.Subnet.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subnet"),
+				"RemoteSubnet4Update",
+				fmt.Sprintf("Unable to move subnet4 id=%d to `%s`, got error: %s", id, config.Subnet.ValueString(), err),
+			)
+			return
+		}
 	}
-	if !config.BootFileName.IsNull() && !config.BootFileName.IsUnknown() && config.BootFileName.ValueString() != "" {
-		update.BootFileName = config.BootFileName.ValueString()
+
+	update := remoteSubnet4FromSchema(config, id)
+
+	structured, owned, ok := r.setStructuredOptions(config, prevOwned, &resp.Diagnostics)
+	if !ok {
+		return
 	}
+	update.OptionData = append(update.OptionData, structured...)
 
 	// nolint: contextcheck
-	respData, err := r.client.RemoteSubnet4Set(config.Hostname.ValueString(), []kea.NewRemoteSubnet4{update})
+	respData, err := client.RemoteSubnet4Set(config.Hostname.ValueString(), []kea.NewRemoteSubnet4{update})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"RemoteSubnet4Update",
@@ -470,10 +654,15 @@ func (r *remoteSubnet4Resource) Update(ctx context.Context, req resource.UpdateR
 	// it into the TF Subnets model.
 	res := respData[0]
 	config.ID = types.Int64Value(int64(res.ID))
-	config.Subnet = types.StringValue(res.Subnet)
+	config.SubnetID = types.Int64Value(int64(res.ID))
+	config.Subnet = prefixType.value(res.Subnet)
+
+	// The option-defs of removed vendor sub-options are no longer used by the subnet.
+	owned = append(owned, r.delVendorOptionDefs(config, staleVendorOptionDefs(prevOwned, owned), &resp.Diagnostics)...)
+	setOwnedVendorOptionDefs(ctx, resp.Private, owned, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	r.saveApplied(ctx, config, &resp.State, &resp.Diagnostics)
 }
 
 // Delete : Deletes an existing resource.
@@ -482,6 +671,7 @@ func (r *remoteSubnet4Resource) Delete(ctx context.Context, req resource.DeleteR
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
 
 	// If the subnet value is empty, add an error to the diagnostics.
 	if config.Subnet.IsNull() || config.Subnet.IsUnknown() || config.Subnet.ValueString() == "" {
@@ -498,17 +688,500 @@ func (r *remoteSubnet4Resource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	// nolint: contextcheck
-	if _, err := r.client.RemoteSubnet4DelByPrefix(config.Hostname.ValueString(), config.Subnet.ValueString()); err != nil {
+	// In merge mode, an adopted subnet is kept and only the managed attributes are removed. A subnet the
+	// resource created is deleted like in replace mode.
+	if config.Merge.ValueBool() && subnet4Adopted(ctx, req.Private, &resp.Diagnostics) {
+		prior, err := subnet4ManagedObject(config)
+		if err == nil {
+			// nolint: contextcheck
+			err = client.RemoteSubnet4Modify(config.Hostname.ValueString(), config.Subnet.ValueString(), func(current map[string]any) error {
+				mergeSubnet4Object(current, prior, map[string]any{})
+				return nil
+			})
+		}
+		if err != nil && !errors.Is(err, kea.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"RemoteSubnet4Delete",
+				fmt.Sprintf("Unable to remove the managed attributes from subnet4, got error: %s", err),
+			)
+			return
+		}
+	} else if _, err := client.RemoteSubnet4DelByPrefix(config.Hostname.ValueString(), config.Subnet.ValueString()); err != nil { // nolint: contextcheck
 		resp.Diagnostics.AddError(
 			"RemoteSubnet4DelByPrefix",
 			fmt.Sprintf("Unable to delete prefix, got error: %s", err),
 		)
 		return
 	}
+
+	// nolint: contextcheck
+	r.delVendorOptionDefs(config, ownedVendorOptionDefs(ctx, req.Private, &resp.Diagnostics), &resp.Diagnostics)
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Subnet4 was written to the configuration-backend", &resp.Diagnostics)
 }
 
 // ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/prefix`, e.g. `kea.example.com/192.168.230.0/24`.
 func (r *remoteSubnet4Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("subnet"), req, resp)
+	hostname, prefix, err := parseSubnet4ImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet"), prefix)...)
+}
+
+// UpgradeState : Upgrades the state of prior schema versions.
+func (r *remoteSubnet4Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {PriorSchema: remoteSubnet4SchemaV0(), StateUpgrader: upgradeRemoteSubnet4StateV0},
+		1: {StateUpgrader: upgradeUserContextState},
+	}
+}
+
+// merge : Merges the managed attributes of config into the existing subnet, removing those only managed in prior,
+// and returns the ID of the subnet. An explicit `subnet_id` must match the ID of the existing subnet.
+func (r *remoteSubnet4Resource) merge(config remoteSubnet4ResourceSchema, prior map[string]any) (int, error) {
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	desired, err := subnet4ManagedObject(config)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = client.RemoteSubnet4Modify(config.Hostname.ValueString(), config.Subnet.ValueString(), func(current map[string]any) error {
+		if id, err = strconv.Atoi(fmt.Sprint(current["id"])); err != nil {
+			return fmt.Errorf("unexpected subnet4 id %v", current["id"])
+		}
+		if !config.SubnetID.IsNull() && !config.SubnetID.IsUnknown() && int(config.SubnetID.ValueInt64()) != id {
+			return fmt.Errorf("subnet_id %d does not match the id %d of the existing subnet", config.SubnetID.ValueInt64(), id)
+		}
+		mergeSubnet4Object(current, prior, desired)
+		return nil
+	})
+	return id, err
+}
+
+// get : Gets the subnet of the resource. In merge mode, it is limited to the attributes managed in config.
+func (r *remoteSubnet4Resource) get(config remoteSubnet4ResourceSchema) (kea.RemoteSubnet4, error) {
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	if !config.Merge.ValueBool() {
+		return client.RemoteSubnet4GetByPrefix(config.Hostname.ValueString(), config.Subnet.ValueString())
+	}
+
+	managed, err := subnet4ManagedObject(config)
+	if err != nil {
+		return kea.RemoteSubnet4{}, err
+	}
+	current, err := client.RemoteSubnet4GetObject(config.Hostname.ValueString(), config.Subnet.ValueString())
+	if err != nil {
+		return kea.RemoteSubnet4{}, err
+	}
+	filterSubnet4Object(current, managed)
+	return decodeSubnet4Object(current)
+}
+
+// subnet4AdoptedKey : Private state key recording whether merge mode adopted an existing subnet on creation.
+const subnet4AdoptedKey = "adopted"
+
+// subnet4Adopted : Reports whether the subnet existed before the resource created it, from the private state.
+// Subnets created before this was recorded, or imported, are taken as adopted, so that they are not deleted.
+func subnet4Adopted(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) bool {
+	b, d := private.GetKey(ctx, subnet4AdoptedKey)
+	diags.Append(d...)
+	return !bytes.Equal(b, []byte("false"))
+}
+
+// setSubnet4Adopted : Records in the private state whether merge mode adopted an existing subnet.
+func setSubnet4Adopted(ctx context.Context, private privateStateSetter, adopted bool, diags *diag.Diagnostics) {
+	diags.Append(private.SetKey(ctx, subnet4AdoptedKey, []byte(strconv.FormatBool(adopted)))...)
+}
+
+// saveApplied : Asks the Kea servers to pick up the written subnet, and saves config into the state.
+func (r *remoteSubnet4Resource) saveApplied(ctx context.Context, config remoteSubnet4ResourceSchema, state *tfsdk.State, diags *diag.Diagnostics) {
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	// Ask the Kea servers to pick up the change from the configuration-backend.
+	// nolint: contextcheck
+	pullAfterApply(client, config.Hostname.ValueString(), "dhcp4", "Subnet4 was written to the configuration-backend", diags)
+
+	optionDataResolveUnknown(config.OptionData)
+	pool4sResolveUnknown(config.Pools)
+	diags.Append(state.Set(ctx, &config)...)
+}
+
+// setStructuredOptions : Renders `classless_static_routes` and `vendor_options` into option-data, and writes the
+// option-defs of the vendor sub-options. Defs that already exist are shared and left as they are, unless the
+// subnet created them, see vendorOptionDefSet. Returns the codes of the defs the subnet owns, and reports whether
+// the option-data can be written.
+func (r *remoteSubnet4Resource) setStructuredOptions(config remoteSubnet4ResourceSchema, owned []int, diags *diag.Diagnostics) ([]kea.OptionData, []int, bool) {
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	opts, defs, err := structuredOptionsFromSchema(config.ClasslessStaticRoutes, config.VendorOptions)
+	if err != nil {
+		diags.AddError("RemoteSubnet4Set", fmt.Sprintf("Unable to encode the structured options, got error: %s", err))
+		return nil, nil, false
+	}
+
+	owns := make([]int, 0, len(defs))
+	for _, def := range defs {
+		// nolint: contextcheck
+		existing, err := client.RemoteOptionDef4Get(config.Hostname.ValueString(), def.Space, def.Code)
+		if err != nil {
+			diags.AddError(
+				"RemoteOptionDef4Get",
+				fmt.Sprintf("Unable to look up vendor sub-option %d `%s` in Kea, got error: %s", def.Code, def.Name, err),
+			)
+			return nil, nil, false
+		}
+		set, err := vendorOptionDefSet(def, existing, slices.Contains(owned, def.Code))
+		if err != nil {
+			diags.AddAttributeError(path.Root("vendor_options"), "Conflicting Vendor Sub-Option", err.Error())
+			return nil, nil, false
+		}
+		if !set {
+			continue
+		}
+		// nolint: contextcheck
+		if err := client.RemoteOptionDef4Set(config.Hostname.ValueString(), def); err != nil {
+			diags.AddError(
+				"RemoteOptionDef4Set",
+				fmt.Sprintf("Unable to define vendor sub-option %d `%s` in Kea, got error: %s", def.Code, def.Name, err),
+			)
+			return nil, nil, false
+		}
+		owns = append(owns, def.Code)
+	}
+	return opts, owns, true
+}
+
+// delVendorOptionDefs : Deletes the vendor sub-option defs with the codes, which the subnet created. Defs that
+// another subnet still uses are kept, as are defs that can't be deleted, which are reported as warnings as the
+// subnet itself was written. The codes of the kept defs are returned so that the next apply tries again.
+func (r *remoteSubnet4Resource) delVendorOptionDefs(config remoteSubnet4ResourceSchema, codes []int, diags *diag.Diagnostics) []int {
+	failed := make([]int, 0)
+	if len(codes) == 0 {
+		return failed
+	}
+	client := remoteClient(r.client, config.RemoteType, config.RemoteHost, config.RemotePort)
+
+	// nolint: contextcheck
+	inUse, err := vendorOptionCodesInUse(client, config.Hostname.ValueString(), config.Subnet.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"RemoteSubnet4GetByPrefix",
+			fmt.Sprintf("Unable to check whether other subnets use the vendor sub-options, keeping their option-defs, got error: %s", err),
+		)
+		return append(failed, codes...)
+	}
+
+	for _, code := range codes {
+		if inUse[code] {
+			failed = append(failed, code)
+			continue
+		}
+		// nolint: contextcheck
+		err := client.RemoteOptionDef4Del(config.Hostname.ValueString(), kea.VendorEncapsulatedOptionsSpace, code)
+		if err != nil && !errors.Is(err, kea.ErrNotFound) {
+			diags.AddWarning(
+				"RemoteOptionDef4Del",
+				fmt.Sprintf("Unable to delete the option-def of vendor sub-option %d from Kea, got error: %s", code, err),
+			)
+			failed = append(failed, code)
+		}
+	}
+	return failed
+}
+
+// vendorOptionCodesInUse : Returns the codes of the vendor sub-options that the subnets other than the prefix,
+// or their pools, carry in Kea.
+func vendorOptionCodesInUse(client *kea.Client, hostname, prefix string) (map[int]bool, error) {
+	subnets, err := client.RemoteSubnet4List(hostname)
+	if err != nil && !errors.Is(err, kea.ErrNotFound) {
+		return nil, err
+	}
+
+	inUse := make(map[int]bool)
+	mark := func(opts []kea.OptionData) {
+		for _, opt := range opts {
+			if opt.Code != nil && opt.Space != nil && *opt.Space == kea.VendorEncapsulatedOptionsSpace {
+				inUse[*opt.Code] = true
+			}
+		}
+	}
+	for _, s := range subnets {
+		if kea.SamePrefix(s.Subnet, prefix) {
+			continue
+		}
+		subnet, err := client.RemoteSubnet4GetByPrefix(hostname, s.Subnet)
+		if err != nil {
+			return nil, err
+		}
+		mark(subnet.OptionData)
+		for _, pool := range subnet.Pools {
+			mark(pool.OptionData)
+		}
+	}
+	return inUse, nil
+}
+
+// ModifyPlan : Fills in the option_data codes and names of the subnet and its pools from the option definitions,
+// and validates the data of custom options against their option-def in Kea.
+func (r *remoteSubnet4Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	client := planRemoteClient(ctx, r.client, req.Plan, &resp.Diagnostics)
+	planOptionData4(ctx, client, req, resp)
+	planPool4sOptionData(ctx, client, req, resp)
+}
+
+// ValidateConfig : Validates the pools against the subnet prefix, the subnet parameters, that
+// option-data codes are unique and standard options carry valid data, that the structured options don't
+// overlap option_data, and the subnet ID allocation settings.
+func (r *remoteSubnet4Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config remoteSubnet4ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateSubnet4Pools(config, &resp.Diagnostics)
+	validateSubnet4Parameters(config, &resp.Diagnostics)
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, &resp.Diagnostics)
+	optionDataResolve(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, standardOptionDef4, &resp.Diagnostics)
+	validateStructuredOptions(config.OptionData, config.ClasslessStaticRoutes, config.VendorOptions, &resp.Diagnostics)
+	validatePool4sOptionData(config.Pools, &resp.Diagnostics)
+
+	if config.SubnetIDStrat.IsUnknown() || config.SubnetIDStrat.IsNull() {
+		return
+	}
+
+	strategy := config.SubnetIDStrat.ValueString()
+	switch {
+	case strategy == subnetIDStrategyExplicit && config.SubnetID.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id"),
+			"Missing Subnet ID",
+			fmt.Sprintf("`subnet_id` must be specified with the `%s` subnet_id_strategy.", subnetIDStrategyExplicit),
+		)
+	case strategy != subnetIDStrategyExplicit && !config.SubnetID.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id_strategy"),
+			"Conflicting Subnet ID Strategy",
+			fmt.Sprintf("`subnet_id` cannot be specified with the `%s` subnet_id_strategy, use `%s` or remove it.", strategy, subnetIDStrategyExplicit),
+		)
+	case !slices.Contains(subnetIDStrategies, strategy):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet_id_strategy"),
+			"Invalid Subnet ID Strategy",
+			fmt.Sprintf("`subnet_id_strategy` must be one of %s, got `%s`.", strings.Join(subnetIDStrategies, ", "), strategy),
+		)
+	}
+}
+
+// subnetIDStrategy : Returns the effective subnet ID strategy and explicit ID of the configuration.
+func subnetIDStrategy(config remoteSubnet4ResourceSchema) (string, *int) {
+	var explicit *int
+	if !config.SubnetID.IsNull() && !config.SubnetID.IsUnknown() {
+		id := int(config.SubnetID.ValueInt64())
+		explicit = &id
+	}
+
+	switch {
+	case !config.SubnetIDStrat.IsNull() && !config.SubnetIDStrat.IsUnknown():
+		return config.SubnetIDStrat.ValueString(), explicit
+	case explicit != nil:
+		return subnetIDStrategyExplicit, explicit
+	default:
+		return subnetIDStrategyDerived, nil
+	}
+}
+
+// validateSubnet4Pools : Checks that every known pool is inside the subnet prefix, and that no two pools overlap.
+// The syntax of the subnet and each pool is checked by their attribute validators.
+func validateSubnet4Pools(config remoteSubnet4ResourceSchema, diags *diag.Diagnostics) {
+	type poolRange struct {
+		index      int
+		start, end net.IP
+	}
+
+	ranges := make([]poolRange, 0, len(config.Pools))
+	for i, p := range config.Pools {
+		if p.Pool.IsNull() || p.Pool.IsUnknown() {
+			continue
+		}
+		start, end, err := parsePool(p.Pool.ValueString())
+		if err != nil {
+			continue
+		}
+		if v := config.Subnet; !v.IsNull() && !v.IsUnknown() && validateIPv4Prefix(v.ValueString()) == nil && !poolInPrefix(start, end, v.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("pools"),
+				"Pool Outside Subnet",
+				fmt.Sprintf("Pool `%s` is not inside the subnet `%s`.", p.Pool.ValueString(), v.ValueString()),
+			)
+		}
+		ranges = append(ranges, poolRange{index: i, start: start, end: end})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return bytes.Compare(ranges[i].start, ranges[j].start) < 0 })
+	for i := 1; i < len(ranges); i++ {
+		if prev, cur := ranges[i-1], ranges[i]; bytes.Compare(cur.start, prev.end) <= 0 {
+			diags.AddAttributeError(
+				path.Root("pools"),
+				"Overlapping Pools",
+				fmt.Sprintf("Pool `%s` overlaps pool `%s`.", config.Pools[cur.index].Pool.ValueString(), config.Pools[prev.index].Pool.ValueString()),
+			)
+		}
+	}
+}
+
+// validateSubnet4Parameters : Checks that the known lease lifetimes are ordered min <= valid <= max, that
+// T1 comes before T2, whether set as timers or as percentages, and that only one DDNS conflict
+// resolution setting is used.
+func validateSubnet4Parameters(config remoteSubnet4ResourceSchema, diags *diag.Diagnostics) {
+	ordered := func(lower, upper types.Int64) bool {
+		return lower.IsNull() || lower.IsUnknown() || upper.IsNull() || upper.IsUnknown() || lower.ValueInt64() <= upper.ValueInt64()
+	}
+
+	if !ordered(config.MinValidLifetime, config.ValidLifetime) || !ordered(config.ValidLifetime, config.MaxValidLifetime) ||
+		!ordered(config.MinValidLifetime, config.MaxValidLifetime) {
+		diags.AddAttributeError(
+			path.Root("valid_lifetime"),
+			"Invalid Lease Lifetime",
+			"Lease lifetimes must be ordered `min_valid_lifetime` <= `valid_lifetime` <= `max_valid_lifetime`.",
+		)
+	}
+	if !ordered(config.RenewTimer, config.RebindTimer) {
+		diags.AddAttributeError(
+			path.Root("renew_timer"),
+			"Invalid Renew Timer",
+			"`renew_timer` must not be greater than `rebind_timer`.",
+		)
+	}
+
+	if !config.DDNSUseConflictResolution.IsNull() && !config.DDNSConflictResolutionMode.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ddns_use_conflict_resolution"),
+			"Conflicting DDNS Conflict Resolution",
+			"`ddns_use_conflict_resolution` cannot be specified with `ddns_conflict_resolution_mode`, which supersedes it.",
+		)
+	}
+
+	t1, t2 := config.T1Percent, config.T2Percent
+	if !t1.IsNull() && !t1.IsUnknown() && !t2.IsNull() && !t2.IsUnknown() && t1.ValueFloat64() >= t2.ValueFloat64() {
+		diags.AddAttributeError(
+			path.Root("t1_percent"),
+			"Invalid T1 Percent",
+			"`t1_percent` must be less than `t2_percent`.",
+		)
+	}
+}
+
+// remoteSubnet4FromSchema : Builds the Kea subnet with the given ID from the resource model. The option-data
+// rendered from `classless_static_routes` and `vendor_options` is not included.
+func remoteSubnet4FromSchema(config remoteSubnet4ResourceSchema, id int) kea.NewRemoteSubnet4 {
+	subnet := kea.NewRemoteSubnet4{
+		ID:          id,
+		Subnet:      config.Subnet.ValueString(),
+		Pools:       pool4sFromSchema(config.Pools),
+		OptionData:  optionDataFromSchema(config.OptionData),
+		UserContext: userContextFromSchema(config.UserContext),
+	}
+	for _, ip := range config.Relay {
+		subnet.Relay.IPAddresses = append(subnet.Relay.IPAddresses, ip.IPAddress.ValueString())
+	}
+
+	if !config.NextServer.IsNull() && !config.NextServer.IsUnknown() && config.NextServer.ValueString() != "" {
+		subnet.NextServer = config.NextServer.ValueString()
+	}
+	if !config.ServerHostname.IsNull() && !config.ServerHostname.IsUnknown() && config.ServerHostname.ValueString() != "" {
+		subnet.ServerHostname = config.ServerHostname.ValueString()
+	}
+	if !config.BootFileName.IsNull() && !config.BootFileName.IsUnknown() && config.BootFileName.ValueString() != "" {
+		subnet.BootFileName = config.BootFileName.ValueString()
+	}
+	subnet.Subnet4Parameters = subnet4ParametersFromSchema(config)
+	return subnet
+}
+
+// subnet4ParametersFromSchema : Converts the subnet parameters of the resource model into Kea, leaving
+// null attributes unset so that they are inherited.
+func subnet4ParametersFromSchema(config remoteSubnet4ResourceSchema) kea.Subnet4Parameters {
+	return kea.Subnet4Parameters{
+		ValidLifetime:         intPointer(config.ValidLifetime),
+		MinValidLifetime:      intPointer(config.MinValidLifetime),
+		MaxValidLifetime:      intPointer(config.MaxValidLifetime),
+		RenewTimer:            intPointer(config.RenewTimer),
+		RebindTimer:           intPointer(config.RebindTimer),
+		CalculateTeeTimes:     config.CalculateTeeTimes.ValueBoolPointer(),
+		T1Percent:             config.T1Percent.ValueFloat64Pointer(),
+		T2Percent:             config.T2Percent.ValueFloat64Pointer(),
+		Authoritative:         config.Authoritative.ValueBoolPointer(),
+		MatchClientID:         config.MatchClientID.ValueBoolPointer(),
+		Interface:             config.Interface.ValueString(),
+		ReservationsGlobal:    config.ReservationsGlobal.ValueBoolPointer(),
+		ReservationsInSubnet:  config.ReservationsInSubnet.ValueBoolPointer(),
+		ReservationsOutOfPool: config.ReservationsOutOfPool.ValueBoolPointer(),
+		StoreExtendedInfo:     config.StoreExtendedInfo.ValueBoolPointer(),
+		CacheThreshold:        config.CacheThreshold.ValueFloat64Pointer(),
+		CacheMaxAge:           intPointer(config.CacheMaxAge),
+		OfferLifetime:         intPointer(config.OfferLifetime),
+		DDNSParameters: kea.DDNSParameters{
+			DDNSSendUpdates:            config.DDNSSendUpdates.ValueBoolPointer(),
+			DDNSOverrideClientUpdate:   config.DDNSOverrideClientUpdate.ValueBoolPointer(),
+			DDNSOverrideNoUpdate:       config.DDNSOverrideNoUpdate.ValueBoolPointer(),
+			DDNSReplaceClientName:      config.DDNSReplaceClientName.ValueString(),
+			DDNSGeneratedPrefix:        config.DDNSGeneratedPrefix.ValueString(),
+			DDNSQualifyingSuffix:       config.DDNSQualifyingSuffix.ValueString(),
+			DDNSUpdateOnRenew:          config.DDNSUpdateOnRenew.ValueBoolPointer(),
+			DDNSUseConflictResolution:  config.DDNSUseConflictResolution.ValueBoolPointer(),
+			DDNSConflictResolutionMode: config.DDNSConflictResolutionMode.ValueString(),
+			HostnameCharSet:            config.HostnameCharSet.ValueString(),
+			HostnameCharReplacement:    config.HostnameCharReplacement.ValueString(),
+		},
+	}
+}
+
+// subnet4ParametersToSchema : Writes the subnet parameters read from Kea into the resource model,
+// parameters the subnet inherits are null.
+func subnet4ParametersToSchema(config *remoteSubnet4ResourceSchema, p kea.Subnet4Parameters) {
+	config.ValidLifetime = int64PointerValue(p.ValidLifetime)
+	config.MinValidLifetime = int64PointerValue(p.MinValidLifetime)
+	config.MaxValidLifetime = int64PointerValue(p.MaxValidLifetime)
+	config.RenewTimer = int64PointerValue(p.RenewTimer)
+	config.RebindTimer = int64PointerValue(p.RebindTimer)
+	config.CalculateTeeTimes = types.BoolPointerValue(p.CalculateTeeTimes)
+	config.T1Percent = types.Float64PointerValue(p.T1Percent)
+	config.T2Percent = types.Float64PointerValue(p.T2Percent)
+	config.Authoritative = types.BoolPointerValue(p.Authoritative)
+	config.MatchClientID = types.BoolPointerValue(p.MatchClientID)
+	config.Interface = stringValueOrNull(p.Interface)
+	config.ReservationsGlobal = types.BoolPointerValue(p.ReservationsGlobal)
+	config.ReservationsInSubnet = types.BoolPointerValue(p.ReservationsInSubnet)
+	config.ReservationsOutOfPool = types.BoolPointerValue(p.ReservationsOutOfPool)
+	config.StoreExtendedInfo = types.BoolPointerValue(p.StoreExtendedInfo)
+	config.CacheThreshold = types.Float64PointerValue(p.CacheThreshold)
+	config.CacheMaxAge = int64PointerValue(p.CacheMaxAge)
+	config.OfferLifetime = int64PointerValue(p.OfferLifetime)
+	config.DDNSSendUpdates = types.BoolPointerValue(p.DDNSSendUpdates)
+	config.DDNSOverrideClientUpdate = types.BoolPointerValue(p.DDNSOverrideClientUpdate)
+	config.DDNSOverrideNoUpdate = types.BoolPointerValue(p.DDNSOverrideNoUpdate)
+	config.DDNSReplaceClientName = stringValueOrNull(p.DDNSReplaceClientName)
+	config.DDNSGeneratedPrefix = stringValueOrNull(p.DDNSGeneratedPrefix)
+	config.DDNSQualifyingSuffix = stringValueOrNull(p.DDNSQualifyingSuffix)
+	config.DDNSUpdateOnRenew = types.BoolPointerValue(p.DDNSUpdateOnRenew)
+	config.DDNSUseConflictResolution = types.BoolPointerValue(p.DDNSUseConflictResolution)
+	config.DDNSConflictResolutionMode = stringValueOrNull(p.DDNSConflictResolutionMode)
+	config.HostnameCharSet = stringValueOrNull(p.HostnameCharSet)
+	config.HostnameCharReplacement = stringValueOrNull(p.HostnameCharReplacement)
 }
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
 )
 
 var testAccExampleResourceConfig = fmt.Sprintf(`
@@ -19,7 +24,7 @@ resource "kea_remote_subnet4_resource" "test" {
     ]
     option_data = [
       {code = 3,   name = "routers",             data = "192.168.225.1"},
-      {code = 15,  name = "domain-name",         data = "example.com"},
+      {name = "domain-name",                     data = "example.com"},
       {code = 6,   name = "domain-name-servers", data = "4.2.2.2, 8.8.8.8"},
     ]
 }`, testAccHostname)
@@ -47,3 +52,98 @@ func TestAccRemoteSubnet4Resource(t *testing.T) {
 		},
 	})
 }
+
+func TestSubnet4ParametersRoundTrip(t *testing.T) {
+	lifetime, renew, rebind := 86400, 43200, 75600
+	t1, t2 := 0.5, 0.875
+	yes, no := true, false
+	want := kea.Subnet4Parameters{
+		ValidLifetime:        &lifetime,
+		RenewTimer:           &renew,
+		RebindTimer:          &rebind,
+		T1Percent:            &t1,
+		T2Percent:            &t2,
+		Authoritative:        &yes,
+		ReservationsInSubnet: &no,
+		Interface:            "eth0",
+		DDNSParameters: kea.DDNSParameters{
+			DDNSSendUpdates:            &yes,
+			DDNSReplaceClientName:      "when-not-present",
+			DDNSQualifyingSuffix:       "example.com.",
+			DDNSConflictResolutionMode: "check-exists-with-dhcid",
+		},
+	}
+
+	var config remoteSubnet4ResourceSchema
+	subnet4ParametersToSchema(&config, want)
+	if !config.MaxValidLifetime.IsNull() || !config.MatchClientID.IsNull() {
+		t.Errorf("unset parameters should be null, got max_valid_lifetime=%v match_client_id=%v", config.MaxValidLifetime, config.MatchClientID)
+	}
+	if got := subnet4ParametersFromSchema(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("subnet parameters did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestValidateSubnet4Parameters(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config remoteSubnet4ResourceSchema
+		errors int
+	}{
+		{name: "unset"},
+		{name: "ordered", config: remoteSubnet4ResourceSchema{
+			MinValidLifetime: types.Int64Value(3600), ValidLifetime: types.Int64Value(86400), MaxValidLifetime: types.Int64Value(86400),
+			RenewTimer: types.Int64Value(100), RebindTimer: types.Int64Value(200),
+			T1Percent: types.Float64Value(0.5), T2Percent: types.Float64Value(0.875),
+		}},
+		{name: "lifetimes", config: remoteSubnet4ResourceSchema{ValidLifetime: types.Int64Value(100), MaxValidLifetime: types.Int64Value(50)}, errors: 1},
+		{name: "timers", config: remoteSubnet4ResourceSchema{RenewTimer: types.Int64Value(300), RebindTimer: types.Int64Value(200)}, errors: 1},
+		{name: "percents", config: remoteSubnet4ResourceSchema{T1Percent: types.Float64Value(0.9), T2Percent: types.Float64Value(0.5)}, errors: 1},
+		{name: "conflict resolution", config: remoteSubnet4ResourceSchema{
+			DDNSUseConflictResolution: types.BoolValue(true), DDNSConflictResolutionMode: types.StringValue("check-with-dhcid"),
+		}, errors: 1},
+	} {
+		var diags diag.Diagnostics
+		validateSubnet4Parameters(tc.config, &diags)
+		if diags.ErrorsCount() != tc.errors {
+			t.Errorf("%s: got %d errors, want %d: %v", tc.name, diags.ErrorsCount(), tc.errors, diags)
+		}
+	}
+}
+
+// testPrivateState : An in-memory private state of a resource.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestSubnet4Adopted(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	// States without the key predate it or were imported, and are kept on destroy.
+	private := testPrivateState{}
+	if !subnet4Adopted(ctx, private, &diags) {
+		t.Error("subnet4Adopted() = false for a state without the key, want true")
+	}
+	for _, adopted := range []bool{false, true} {
+		setSubnet4Adopted(ctx, private, adopted, &diags)
+		if got := subnet4Adopted(ctx, private, &diags); got != adopted {
+			t.Errorf("subnet4Adopted() = %v, want %v", got, adopted)
+		}
+	}
+
+	setOwnedVendorOptionDefs(ctx, private, []int{1, 2}, &diags)
+	if got := ownedVendorOptionDefs(ctx, private, &diags); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("ownedVendorOptionDefs() = %v, want [1 2]", got)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &reservation6DataSource{}
+	_ datasource.DataSourceWithConfigure = &reservation6DataSource{}
+)
+
+// NewReservation6DataSource : Creates a new empty data source client.
+func NewReservation6DataSource() datasource.DataSource {
+	return &reservation6DataSource{}
+}
+
+type (
+	// reservation6DataSource defines the data source client.
+	reservation6DataSource struct {
+		client *kea.Client
+	}
+
+	// reservation6DataSourceSchema describes the data source data model.
+	// Maps to the source schema data.
+	reservation6DataSourceSchema struct {
+		IPAddress           types.String                       `tfsdk:"ip_address"`
+		IdentifierType      types.String                       `tfsdk:"identifier_type"`
+		Identifier          types.String                       `tfsdk:"identifier"`
+		SubnetID            types.Int64                        `tfsdk:"subnet_id"`
+		OperationTarget     types.String                       `tfsdk:"operation_target"`
+		Hostname            types.String                       `tfsdk:"hostname"`
+		ReservationHostname types.String                       `tfsdk:"reservation_hostname"`
+		DuID                types.String                       `tfsdk:"duid"`
+		HwAddress           types.String                       `tfsdk:"hw_address"`
+		FlexID              types.String                       `tfsdk:"flex_id"`
+		IPAddresses         []types.String                     `tfsdk:"ip_addresses"`
+		Prefixes            []types.String                     `tfsdk:"prefixes"`
+		ClientClasses       []types.String                     `tfsdk:"client_classes"`
+		OptionData          []reservationDataSourceOptionModel `tfsdk:"option_data"`
+		UserContext         jsonObjectValue                    `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Defines the data source metadata.
+func (d *reservation6DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation6_data_source"
+}
+
+// Schema : Defines the data source schema.
+func (d *reservation6DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reservation6 data source, a DHCPv6 host reservation",
+		Attributes: map[string]schema.Attribute{
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "Subnet6 ID to fetch the reservation from. e.g. `1`. Defaults to `0`, the global reservations.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"operation_target": schema.StringAttribute{
+				MarkdownDescription: "Kea 2.4+ `operation-target` of the lookup. One of `memory`, `database`, `all` or `default`.",
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "Reserved IPv6 address to fetch the reservation by. e.g. `2001:db8:1::10`. " +
+					"Conflicts with `identifier`.",
+				Optional: true,
+			},
+			"identifier_type": schema.StringAttribute{
+				MarkdownDescription: "Type of `identifier`. One of `duid`, `hw-address` or `flex-id`.",
+				Optional:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Host identifier to fetch for this reservation, of the type `identifier_type`. " +
+					"e.g. `00:03:00:01:94:8e:d3:db:d8:c5`. Conflicts with `ip_address`.",
+				Optional: true,
+			},
+			"reservation_hostname": schema.StringAttribute{Computed: true},
+			"duid":                 schema.StringAttribute{Computed: true},
+			"hw_address":           schema.StringAttribute{Computed: true},
+			"flex_id":              schema.StringAttribute{Computed: true},
+			"ip_addresses":         schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"prefixes":             schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"client_classes":       schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"option_data": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code":        schema.Int64Attribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"data":        schema.StringAttribute{Computed: true},
+						"always_send": schema.BoolAttribute{Computed: true},
+						"space":       schema.StringAttribute{Computed: true},
+						"csv_format":  schema.BoolAttribute{Computed: true},
+						"never_send":  schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+			"user_context": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary data tied to the reservation, as a JSON object.",
+				Computed:            true,
+				CustomType:          jsonObjectType{},
+			},
+		},
+	}
+}
+
+// Configure : Configures the data source client.
+func (d *reservation6DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read : Reads the data source data into the Terraform state.
+func (d *reservation6DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Define an empty configuration.
+	var config reservation6DataSourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Validate that only one of `ip_address` or `identifier` is specified.
+	if config.IPAddress.IsNull() == config.Identifier.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of `ip_address` or `identifier` must be specified.",
+		)
+	}
+
+	// Validate that `identifier` is always specified with its type.
+	if config.Identifier.IsNull() != config.IdentifierType.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"`identifier` and `identifier_type` must be specified together.",
+		)
+	}
+
+	// Validate that a `hostname` is specified.
+	if config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"A `hostname` must be specified. DNS name or IP address of the Kea DHCP server.",
+		)
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SubnetID.IsNull() {
+		config.SubnetID = types.Int64Value(kea.GlobalSubnetID)
+	}
+
+	identifierType, identifier := config.IdentifierType.ValueString(), config.Identifier.ValueString()
+	if !config.IPAddress.IsNull() {
+		identifierType, identifier = kea.IdentifierIPAddress, config.IPAddress.ValueString()
+	}
+
+	// nolint: contextcheck
+	respData, err := d.client.Reservation6Get(
+		config.Hostname.ValueString(),
+		identifierType,
+		identifier,
+		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
+	)
+	if err != nil {
+		// Only return an error if the error is NOT reservation not found.
+		if !strings.Contains(err.Error(), "not found") {
+			resp.Diagnostics.AddError(
+				"Reservation6Get",
+				fmt.Sprintf("Unable to read reservation6, got error: %s", err),
+			)
+			return
+		}
+	}
+	if respData == nil {
+		resp.Diagnostics.AddError(
+			"Reservation6Get",
+			fmt.Sprintf("Reservation6 `%s` of type `%s` not found in subnet %d", identifier, identifierType, config.SubnetID.ValueInt64()),
+		)
+		return
+	}
+
+	// Marshalling the response data taken from Kea, and write
+	// it into the TF model.
+	config.ReservationHostname = types.StringValue(respData.Hostname)
+	config.DuID = types.StringValue(respData.DuID)
+	config.HwAddress = types.StringValue(respData.HwAddress)
+	config.FlexID = types.StringValue(respData.FlexID)
+	config.IPAddresses = stringValues(respData.IPAddresses)
+	config.Prefixes = stringValues(respData.Prefixes)
+	config.ClientClasses = stringValues(respData.ClientClasses)
+	config.OptionData = reservationDataSourceOptionModels(respData.OptionData, kea.DHCP6OptionSpace)
+	config.UserContext = userContextToSchema(jsonObjectValue{StringValue: types.StringNull()}, respData.UserContext, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
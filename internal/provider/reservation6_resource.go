@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &reservation6Resource{}
+	_ resource.ResourceWithImportState    = &reservation6Resource{}
+	_ resource.ResourceWithValidateConfig = &reservation6Resource{}
+	_ resource.ResourceWithUpgradeState   = &reservation6Resource{}
+)
+
+// NewReservation6Resource : Creates a new empty resource client.
+func NewReservation6Resource() resource.Resource {
+	return &reservation6Resource{}
+}
+
+type (
+	// reservation6Resource defines the resource implementation.
+	reservation6Resource struct {
+		client *kea.Client
+	}
+
+	// reservation6ResourceSchema describes the resource data model.
+	reservation6ResourceSchema struct {
+		Hostname            types.String              `tfsdk:"hostname"`
+		SubnetID            types.Int64               `tfsdk:"subnet_id"`
+		OperationTarget     types.String              `tfsdk:"operation_target"`
+		ReservationHostname types.String              `tfsdk:"reservation_hostname"`
+		DuID                networkStringValue        `tfsdk:"duid"`
+		HwAddress           networkStringValue        `tfsdk:"hw_address"`
+		FlexID              networkStringValue        `tfsdk:"flex_id"`
+		IPAddresses         types.List                `tfsdk:"ip_addresses"`
+		Prefixes            types.List                `tfsdk:"prefixes"`
+		ClientClasses       types.List                `tfsdk:"client_classes"`
+		OptionData          []optionDataResourceModel `tfsdk:"option_data"`
+		UserContext         jsonObjectValue           `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Returns the resource type name and supported features.
+func (r *reservation6Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation6_resource"
+}
+
+// Schema : Returns the resource schema.
+func (r *reservation6Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reservation6 resource, a DHCPv6 host reservation",
+		// Version 1 models `user_context` as a JSON object, see UpgradeState.
+		Version: 1,
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "Subnet6 ID of the subnet to reserve in Kea. e.g. `1`. Defaults to `0`, a global reservation.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(kea.GlobalSubnetID),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"operation_target": schema.StringAttribute{
+				MarkdownDescription: "Kea 2.4+ `operation-target` used to write, read and delete the reservation. One of `memory` " +
+					"(server configuration), `database` (hosts database), `all` or `default`. Unset uses the server default. " +
+					"Changing it replaces the reservation.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reservation_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname to define this reservation. e.g. `switch.example.com`",
+				Optional:            true,
+			},
+			"duid": schema.StringAttribute{
+				MarkdownDescription: "DUID of the client, in hexadecimal. e.g. `00:03:00:01:94:8e:d3:db:d8:c5`. Exactly one of " +
+					"`duid`, `hw_address` or `flex_id` must be specified to identify the client.",
+				Optional:   true,
+				CustomType: hexIdentifierType,
+				Validators: []validator.String{hexIdentifierValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hw_address": schema.StringAttribute{
+				MarkdownDescription: "Hw-address/MAC address of the client.",
+				Optional:            true,
+				CustomType:          macAddressType,
+				Validators:          []validator.String{macAddressValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flex_id": schema.StringAttribute{
+				MarkdownDescription: "Flex-Id computed by the flex-id hook for this reservation, in hexadecimal or as a quoted string.",
+				Optional:            true,
+				CustomType:          hexIdentifierType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv6 addresses reserved for the client. e.g. `[\"2001:db8:1::10\"]`",
+				ElementType:         ipAddressType,
+				Optional:            true,
+			},
+			"prefixes": schema.ListAttribute{
+				MarkdownDescription: "IPv6 prefixes delegated to the client. e.g. `[\"2001:db8:2:abcd::/64\"]`",
+				ElementType:         prefixType,
+				Optional:            true,
+			},
+			"client_classes": schema.ListAttribute{
+				MarkdownDescription: "Client classes to assign to clients matching this reservation. e.g. `[\"voip\"]`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"option_data":  optionDataAttribute(kea.DHCP6OptionSpace),
+			"user_context": userContextAttribute("reservation"),
+		},
+	}
+}
+
+// Configure : Configures the resource client data and populates the client interface.
+func (r *reservation6Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create : Creates a new resource.
+func (r *reservation6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config reservation6ResourceSchema
+
+	// Read Terraform plan data into the model, which holds the `subnet_id` default.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("Reservation6Add", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resv := reservation6FromSchema(config)
+
+	// nolint: contextcheck
+	if err := r.client.Reservation6Add(config.Hostname.ValueString(), resv, kea.WithOperationTarget(config.OperationTarget.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Reservation6Add",
+			fmt.Sprintf("Unable to create reservation6 in Kea, got error: %s | %v", err, resv),
+		)
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Read : Reads the resource data into the Terraform state.
+func (r *reservation6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var config reservation6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("Reservation6Get", "`hostname` field is required")
+	}
+
+	//  An imported reservation may only know one of its reserved addresses.
+	identifierType, identifier := reservation6Identifier(config)
+	if identifier == "" {
+		resp.Diagnostics.AddError("Reservation6Get", "an identifier field or `ip_addresses` is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// nolint: contextcheck
+	respData, err := r.client.Reservation6Get(
+		config.Hostname.ValueString(),
+		identifierType,
+		identifier,
+		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reservation6Get",
+			fmt.Sprintf("Unable to read reservation6, got error: %s", err),
+		)
+		return
+	}
+
+	// The reservation was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Marshalling the response data taken from Kea, and write
+	// it into the TF model.
+	reservation6ToSchema(&config, respData, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Update : Updates an existing resource.
+func (r *reservation6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config reservation6ResourceSchema
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("Reservation6Update", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resv := reservation6FromSchema(config)
+
+	// nolint: contextcheck
+	if err := r.client.Reservation6Update(config.Hostname.ValueString(), resv, kea.WithOperationTarget(config.OperationTarget.ValueString())); err != nil {
+		resp.Diagnostics.AddError(
+			"Reservation6Update",
+			fmt.Sprintf("Unable to update reservation6 in Kea, got error: %s | %v", err, resv),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// Delete : Deletes an existing resource.
+func (r *reservation6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var config reservation6ResourceSchema
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
+
+	//  If the hostname value is empty, add an error to the diagnostics.
+	if config.Hostname.IsNull() || config.Hostname.IsUnknown() || config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError("Reservation6Del", "`hostname` field is required")
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identifierType, identifier := reservation6Identifier(config)
+
+	// nolint: contextcheck
+	if err := r.client.Reservation6Del(
+		config.Hostname.ValueString(),
+		identifierType,
+		identifier,
+		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
+	); err != nil {
+		resp.Diagnostics.AddError(
+			"Reservation6Del",
+			fmt.Sprintf("Unable to delete reservation6, got error: %s", err),
+		)
+		return
+	}
+}
+
+// ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/subnet_id/ip-address` or `hostname/subnet_id/identifier-type=identifier`,
+// e.g. `kea.example.com/1/2001:db8:1::10` or `kea.example.com/1/duid=00:03:00:01:94:8e:d3:db:d8:c5`.
+func (r *reservation6Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	hostname, subnetID, identifierType, identifier, err := parseReservation6ImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet_id"), int64(subnetID))...)
+	switch identifierType {
+	case kea.IdentifierIPAddress:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_addresses"), []string{identifier})...)
+	default:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(strings.ReplaceAll(identifierType, "-", "_")), identifier)...)
+	}
+}
+
+// UpgradeState : Upgrades the state of prior schema versions.
+func (r *reservation6Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeUserContextState},
+	}
+}
+
+// ValidateConfig : Validates the operation target, the reserved addresses and prefixes, that option-data codes
+// are unique per space, and that the reservation is identified by exactly one identifier.
+func (r *reservation6Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config reservation6ResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.OperationTarget; !v.IsNull() && !v.IsUnknown() && !slices.Contains(kea.OperationTargets, v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operation_target"),
+			"Invalid Operation Target",
+			fmt.Sprintf("`operation_target` must be one of %s, got `%s`.", strings.Join(kea.OperationTargets, ", "), v.ValueString()),
+		)
+	}
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP6OptionSpace, &resp.Diagnostics)
+
+	for i, v := range config.IPAddresses.Elements() {
+		if s, ok := v.(networkStringValue); ok && !s.IsUnknown() && !s.IsNull() {
+			if err := validateIPv6Address(s.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("ip_addresses").AtListIndex(i), "Invalid IPv6 Address", err.Error())
+			}
+		}
+	}
+	for i, v := range config.Prefixes.Elements() {
+		if s, ok := v.(networkStringValue); ok && !s.IsUnknown() && !s.IsNull() {
+			if err := validateIPv6Prefix(s.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("prefixes").AtListIndex(i), "Invalid IPv6 Prefix", err.Error())
+			}
+		}
+	}
+
+	set := make([]string, 0)
+	for name, v := range map[string]types.String{
+		"duid":       config.DuID.StringValue,
+		"hw_address": config.HwAddress.StringValue,
+		"flex_id":    config.FlexID.StringValue,
+	} {
+		// An unknown identifier can't be validated until apply.
+		if v.IsUnknown() {
+			return
+		}
+		if !v.IsNull() && v.ValueString() != "" {
+			set = append(set, "`"+name+"`")
+		}
+	}
+
+	switch len(set) {
+	case 1:
+	case 0:
+		resp.Diagnostics.AddError(
+			"Missing Reservation Identifier",
+			"Exactly one of `duid`, `hw_address` or `flex_id` must be specified.",
+		)
+	default:
+		sort.Strings(set)
+		resp.Diagnostics.AddError(
+			"Conflicting Reservation Identifiers",
+			fmt.Sprintf("Exactly one of `duid`, `hw_address` or `flex_id` must be specified, got %s.", strings.Join(set, ", ")),
+		)
+	}
+}
+
+// reservation6Identifier : Returns the identifier type and identifier to address the reservation
+// with in Kea, falling back to the first reserved address when no identifier is known, e.g. on import.
+func reservation6Identifier(config reservation6ResourceSchema) (string, string) {
+	for _, v := range []struct {
+		identifierType string
+		value          types.String
+	}{
+		{kea.IdentifierDUID, config.DuID.StringValue},
+		{kea.IdentifierHwAddress, config.HwAddress.StringValue},
+		{kea.IdentifierFlexID, config.FlexID.StringValue},
+	} {
+		if v.value.ValueString() != "" {
+			return v.identifierType, v.value.ValueString()
+		}
+	}
+	if addrs := stringListElements(config.IPAddresses); len(addrs) > 0 {
+		return kea.IdentifierIPAddress, addrs[0]
+	}
+	return kea.IdentifierIPAddress, ""
+}
+
+// reservation6FromSchema : Converts the resource model into a Kea DHCPv6 reservation.
+func reservation6FromSchema(config reservation6ResourceSchema) kea.Reservation6 {
+	return kea.Reservation6{
+		Hostname:      config.ReservationHostname.ValueString(),
+		SubnetID:      int(config.SubnetID.ValueInt64()),
+		DuID:          config.DuID.ValueString(),
+		HwAddress:     config.HwAddress.ValueString(),
+		FlexID:        config.FlexID.ValueString(),
+		IPAddresses:   stringListElements(config.IPAddresses),
+		Prefixes:      stringListElements(config.Prefixes),
+		ClientClasses: stringListElements(config.ClientClasses),
+		OptionData:    optionDataFromSchema(config.OptionData),
+		UserContext:   userContextFromSchema(config.UserContext),
+	}
+}
+
+// reservation6ToSchema : Writes every field of the Kea DHCPv6 reservation into the resource model.
+func reservation6ToSchema(config *reservation6ResourceSchema, res *kea.Reservation6, diags *diag.Diagnostics) {
+	config.SubnetID = types.Int64Value(int64(res.SubnetID))
+	config.ReservationHostname = stringValueOrNull(res.Hostname)
+	config.DuID = hexIdentifierType.valueOrNull(res.DuID)
+	config.HwAddress = macAddressType.valueOrNull(res.HwAddress)
+	config.FlexID = hexIdentifierType.valueOrNull(res.FlexID)
+	config.IPAddresses = ipAddressType.listValue(config.IPAddresses, res.IPAddresses, diags)
+	config.Prefixes = prefixType.listValue(config.Prefixes, res.Prefixes, diags)
+	config.ClientClasses = stringListValue(config.ClientClasses, res.ClientClasses, diags)
+	config.OptionData = optionDataToSchema(config.OptionData, res.OptionData, kea.DHCP6OptionSpace)
+	config.UserContext = userContextToSchema(config.UserContext, res.UserContext, diags)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestReservation6SchemaRoundTrip(t *testing.T) {
+	code := 23
+	space := kea.DHCP6OptionSpace
+	want := kea.Reservation6{
+		ClientClasses: []string{"cpe"},
+		DuID:          "00:03:00:01:94:8e:d3:db:d8:c5",
+		Hostname:      "cpe.example.com",
+		IPAddresses:   []string{"2001:db8:1::10", "2001:db8:1::11"},
+		Prefixes:      []string{"2001:db8:2:abcd::/64"},
+		SubnetID:      1,
+		OptionData: []kea.OptionData{
+			{Code: &code, Name: "dns-servers", Data: "2001:db8::53", Space: &space},
+		},
+		UserContext: map[string]any{"site": "AUS"},
+	}
+
+	var diags diag.Diagnostics
+	config := reservation6ResourceSchema{
+		IPAddresses:   types.ListNull(ipAddressType),
+		Prefixes:      types.ListNull(prefixType),
+		ClientClasses: types.ListNull(types.StringType),
+		UserContext:   jsonObjectValue{StringValue: types.StringNull()},
+	}
+	reservation6ToSchema(&config, &want, &diags)
+	if diags.HasError() {
+		t.Fatalf("reservation6ToSchema() diagnostics: %v", diags)
+	}
+
+	got := reservation6FromSchema(config)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reservation6 did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestValidateIPv6(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		prefix  bool
+		wantErr bool
+	}{
+		{value: "2001:db8:1::10"},
+		{value: "192.168.230.10", wantErr: true},
+		{value: "2001:db8:1::/48", wantErr: true},
+		{value: "2001:db8:1::/48", prefix: true},
+		{value: "2001:db8:1::1/48", prefix: true, wantErr: true},
+		{value: "192.168.0.0/16", prefix: true, wantErr: true},
+	} {
+		validate := validateIPv6Address
+		if tc.prefix {
+			validate = validateIPv6Prefix
+		}
+		if err := validate(tc.value); (err != nil) != tc.wantErr {
+			t.Errorf("validate(%q, prefix=%v) error = %v, wantErr %v", tc.value, tc.prefix, err, tc.wantErr)
+		}
+	}
+}
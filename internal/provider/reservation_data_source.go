@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -37,10 +37,15 @@ type (
 	// Maps to the source schema data.
 	reservationDataSourceSchema struct {
 		IPOrMac             types.String                       `tfsdk:"ip_or_mac_address"`
+		IdentifierType      types.String                       `tfsdk:"identifier_type"`
+		Identifier          types.String                       `tfsdk:"identifier"`
 		SubnetID            types.Int64                        `tfsdk:"subnet_id"`
+		OperationTarget     types.String                       `tfsdk:"operation_target"`
 		Hostname            types.String                       `tfsdk:"hostname"`
 		ReservationHostname types.String                       `tfsdk:"reservation_hostname"`
 		BootFileName        types.String                       `tfsdk:"boot_file_name"`
+		ClientClasses       []types.String                     `tfsdk:"client_classes"`
+		ServerHostname      types.String                       `tfsdk:"server_hostname"`
 		ClientID            types.String                       `tfsdk:"client_id"`
 		CircuitID           types.String                       `tfsdk:"circuit_id"`
 		DuID                types.String                       `tfsdk:"duid"`
@@ -49,7 +54,7 @@ type (
 		HwAddress           types.String                       `tfsdk:"hw_address"`
 		NextServer          types.String                       `tfsdk:"next_server"`
 		OptionData          []reservationDataSourceOptionModel `tfsdk:"option_data"`
-		UserContext         types.Map                          `tfsdk:"user_context"`
+		UserContext         jsonObjectValue                    `tfsdk:"user_context"`
 	}
 
 	// reservationDataSourceOptionModel : Represents a single option-data entry in Kea.
@@ -58,6 +63,9 @@ type (
 		Data       types.String `tfsdk:"data"`
 		Name       types.String `tfsdk:"name"`
 		AlwaysSend types.Bool   `tfsdk:"always_send"`
+		Space      types.String `tfsdk:"space"`
+		CSVFormat  types.Bool   `tfsdk:"csv_format"`
+		NeverSend  types.Bool   `tfsdk:"never_send"`
 	}
 )
 
@@ -73,19 +81,36 @@ func (d *reservationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		MarkdownDescription: "Reservation data source",
 		Attributes: map[string]schema.Attribute{
 			"subnet_id": schema.Int64Attribute{
-				MarkdownDescription: "Subnet4 ID to fetch the reservations from. e.g. 1921682300`",
-				Required:            true,
+				MarkdownDescription: "Subnet4 ID to fetch the reservations from. e.g. 1921682300`. Defaults to `0`, the global reservations.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"operation_target": schema.StringAttribute{
+				MarkdownDescription: "Kea 2.4+ `operation-target` of the lookup. One of `memory`, `database`, `all` or `default`.",
+				Optional:            true,
 			},
 			"hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
 				Required:            true,
 			},
 			"ip_or_mac_address": schema.StringAttribute{
-				MarkdownDescription: "IP address or mac-address to fetch for this reservation. e.g. 192.168.230.50`",
-				Required:            true,
+				MarkdownDescription: "IP address or mac-address to fetch for this reservation. e.g. 192.168.230.50`. " +
+					"Conflicts with `identifier`.",
+				Optional: true,
+			},
+			"identifier_type": schema.StringAttribute{
+				MarkdownDescription: "Type of `identifier`. One of `hw-address`, `client-id`, `duid`, `circuit-id` or `flex-id`.",
+				Optional:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Host identifier to fetch for this reservation, of the type `identifier_type`. " +
+					"e.g. `01:94:8e:d3:db:d8:c5`. Conflicts with `ip_or_mac_address`.",
+				Optional: true,
 			},
 			"reservation_hostname": schema.StringAttribute{Computed: true},
 			"boot_file_name":       schema.StringAttribute{Computed: true},
+			"client_classes":       schema.ListAttribute{ElementType: types.StringType, Computed: true},
+			"server_hostname":      schema.StringAttribute{Computed: true},
 			"client_id":            schema.StringAttribute{Computed: true},
 			"circuit_id":           schema.StringAttribute{Computed: true},
 			"duid":                 schema.StringAttribute{Computed: true},
@@ -101,10 +126,17 @@ func (d *reservationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 						"name":        schema.StringAttribute{Computed: true},
 						"data":        schema.StringAttribute{Computed: true},
 						"always_send": schema.BoolAttribute{Computed: true},
+						"space":       schema.StringAttribute{Computed: true},
+						"csv_format":  schema.BoolAttribute{Computed: true},
+						"never_send":  schema.BoolAttribute{Computed: true},
 					},
 				},
 			},
-			"user_context": schema.MapAttribute{ElementType: types.StringType, Computed: true},
+			"user_context": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary data tied to the reservation, as a JSON object.",
+				Computed:            true,
+				CustomType:          jsonObjectType{},
+			},
 		},
 	}
 }
@@ -137,11 +169,19 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Also append any diagnostics to the diagnostics list.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-	// Validate that only one of `prefix` or `subnet_id` is specified.
-	if config.IPOrMac.IsNull() {
+	// Validate that only one of `ip_or_mac_address` or `identifier` is specified.
+	if config.IPOrMac.IsNull() == config.Identifier.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of `ip_or_mac_address` or `identifier` must be specified.",
+		)
+	}
+
+	// Validate that `identifier` is always specified with its type.
+	if config.Identifier.IsNull() != config.IdentifierType.IsNull() {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"`ip_or_mac_address` must be specified.",
+			"`identifier` and `identifier_type` must be specified together.",
 		)
 	}
 
@@ -158,11 +198,25 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	if config.SubnetID.IsNull() {
+		config.SubnetID = types.Int64Value(kea.GlobalSubnetID)
+	}
+
+	identifierType, identifier := config.IdentifierType.ValueString(), config.Identifier.ValueString()
+	if !config.IPOrMac.IsNull() {
+		identifierType, identifier = kea.IdentifierIPAddress, config.IPOrMac.ValueString()
+		if net.ParseIP(identifier) == nil {
+			identifierType = kea.IdentifierHwAddress
+		}
+	}
+
 	// nolint: contextcheck
 	respData, err := d.client.ReservationGet(
 		config.Hostname.ValueString(),
-		config.IPOrMac.ValueString(),
+		identifierType,
+		identifier,
 		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
 	)
 	if err != nil {
 		// Only return an error if the error is NOT subnet not found.
@@ -179,6 +233,13 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if respData == nil {
+		resp.Diagnostics.AddError(
+			"ReservationGet",
+			fmt.Sprintf("Reservation `%s` of type `%s` not found in subnet %d", identifier, identifierType, config.SubnetID.ValueInt64()),
+		)
+		return
+	}
 
 	// Marshalling the response data taken from Kea, and write
 	// it into the TF Subnets model.
@@ -192,34 +253,10 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	config.IPAddress = types.StringValue(respData.IPAddress)
 	config.HwAddress = types.StringValue(respData.HwAddress)
 	config.NextServer = types.StringValue(respData.NextServer)
-
-	config.OptionData = func() []reservationDataSourceOptionModel {
-		r := make([]reservationDataSourceOptionModel, 0)
-		for _, v := range respData.OptionData {
-			code := 0
-			if v.Code != nil {
-				code = *v.Code
-			}
-			r = append(r, reservationDataSourceOptionModel{
-				Code:       types.Int64Value(int64(code)),
-				Data:       types.StringValue(v.Data),
-				Name:       types.StringValue(v.Name),
-				AlwaysSend: types.BoolValue(v.AlwaysSend),
-			})
-		}
-		return r
-	}()
-	if respData.UserContext != nil {
-		config.UserContext = func() types.Map {
-			fr := make(map[string]attr.Value)
-			for k, v := range respData.UserContext {
-				fr[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-			mv, diags := types.MapValue(types.StringType, fr)
-			resp.Diagnostics.Append(diags...)
-			return mv
-		}()
-	}
+	config.ServerHostname = types.StringValue(respData.ServerHostname)
+	config.ClientClasses = stringValues(respData.ClientClasses)
+	config.OptionData = reservationDataSourceOptionModels(respData.OptionData, kea.DHCP4OptionSpace)
+	config.UserContext = userContextToSchema(jsonObjectValue{StringValue: types.StringNull()}, respData.UserContext, &resp.Diagnostics)
 
 	// If there are any diagnostics errors, stop here.
 	if resp.Diagnostics.HasError() {
@@ -233,3 +270,35 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
+
+// reservationDataSourceOptionModels : Converts Kea option-data into the data source option-data model,
+// filling in Kea's defaults, and the given option space, for the fields it does not report.
+func reservationDataSourceOptionModels(opts []kea.OptionData, space string) []reservationDataSourceOptionModel {
+	r := make([]reservationDataSourceOptionModel, 0, len(opts))
+	for _, v := range opts {
+		code := 0
+		if v.Code != nil {
+			code = *v.Code
+		}
+		m := reservationDataSourceOptionModel{
+			Code:       types.Int64Value(int64(code)),
+			Data:       types.StringValue(v.Data),
+			Name:       types.StringValue(v.Name),
+			AlwaysSend: types.BoolValue(v.AlwaysSend),
+			Space:      types.StringValue(space),
+			CSVFormat:  types.BoolValue(true),
+			NeverSend:  types.BoolValue(false),
+		}
+		if v.Space != nil && *v.Space != "" {
+			m.Space = types.StringValue(*v.Space)
+		}
+		if v.CSVFormat != nil {
+			m.CSVFormat = types.BoolValue(*v.CSVFormat)
+		}
+		if v.NeverSend != nil {
+			m.NeverSend = types.BoolValue(*v.NeverSend)
+		}
+		r = append(r, m)
+	}
+	return r
+}
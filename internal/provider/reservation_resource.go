@@ -3,14 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
+	"sort"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
@@ -18,8 +24,11 @@ import (
 
 var (
 	// Ensure provider defined types fully satisfy framework interfaces.
-	_ resource.Resource                = &reservationResource{}
-	_ resource.ResourceWithImportState = &reservationResource{}
+	_ resource.Resource                   = &reservationResource{}
+	_ resource.ResourceWithImportState    = &reservationResource{}
+	_ resource.ResourceWithValidateConfig = &reservationResource{}
+	_ resource.ResourceWithModifyPlan     = &reservationResource{}
+	_ resource.ResourceWithUpgradeState   = &reservationResource{}
 )
 
 // NewReservationResource : Creates a new empty resource client.
@@ -35,27 +44,22 @@ type (
 
 	// reservationResourceSchema describes the resource data model.
 	reservationResourceSchema struct {
-		SubnetID            types.Int64                      `tfsdk:"subnet_id"`
-		Hostname            types.String                     `tfsdk:"hostname"`
-		ReservationHostname types.String                     `tfsdk:"reservation_hostname"`
-		BootFileName        types.String                     `tfsdk:"boot_file_name"`
-		ClientID            types.String                     `tfsdk:"client_id"`
-		CircuitID           types.String                     `tfsdk:"circuit_id"`
-		DuID                types.String                     `tfsdk:"duid"`
-		FlexID              types.String                     `tfsdk:"flex_id"`
-		IPAddress           types.String                     `tfsdk:"ip_address"`
-		HwAddress           types.String                     `tfsdk:"hw_address"`
-		NextServer          types.String                     `tfsdk:"next_server"`
-		OptionData          []reservationOptionResourceModel `tfsdk:"option_data"`
-		UserContext         types.Map                        `tfsdk:"user_context"`
-	}
-
-	// reservationOptionResourceModel : Represents a single option-data entry in Kea.
-	reservationOptionResourceModel struct {
-		Code       types.Int64  `tfsdk:"code"`
-		Data       types.String `tfsdk:"data"`
-		Name       types.String `tfsdk:"name"`
-		AlwaysSend types.Bool   `tfsdk:"always_send"`
+		SubnetID            types.Int64               `tfsdk:"subnet_id"`
+		OperationTarget     types.String              `tfsdk:"operation_target"`
+		Hostname            types.String              `tfsdk:"hostname"`
+		ReservationHostname types.String              `tfsdk:"reservation_hostname"`
+		BootFileName        types.String              `tfsdk:"boot_file_name"`
+		ClientClasses       types.List                `tfsdk:"client_classes"`
+		ServerHostname      types.String              `tfsdk:"server_hostname"`
+		ClientID            networkStringValue        `tfsdk:"client_id"`
+		CircuitID           networkStringValue        `tfsdk:"circuit_id"`
+		DuID                networkStringValue        `tfsdk:"duid"`
+		FlexID              networkStringValue        `tfsdk:"flex_id"`
+		IPAddress           networkStringValue        `tfsdk:"ip_address"`
+		HwAddress           networkStringValue        `tfsdk:"hw_address"`
+		NextServer          networkStringValue        `tfsdk:"next_server"`
+		OptionData          []optionDataResourceModel `tfsdk:"option_data"`
+		UserContext         jsonObjectValue           `tfsdk:"user_context"`
 	}
 )
 
@@ -69,6 +73,8 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Reservation resource",
+		// Version 1 models `option_data` as a set, version 2 `user_context` as a JSON object, see UpgradeState.
+		Version: 2,
 
 		Attributes: map[string]schema.Attribute{
 			"hostname": schema.StringAttribute{
@@ -76,20 +82,40 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Required:            true,
 			},
 			"subnet_id": schema.Int64Attribute{
-				MarkdownDescription: "Subnet ID of the subnet to reserve in Kea. e.g. `1921682300`",
-				Required:            true,
+				MarkdownDescription: "Subnet ID of the subnet to reserve in Kea. e.g. `1921682300`. Defaults to `0`, " +
+					"a global reservation that applies to the client in any subnet with `reservations-global` enabled.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(kea.GlobalSubnetID),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"operation_target": schema.StringAttribute{
+				MarkdownDescription: "Kea 2.4+ `operation-target` used to write, read and delete the reservation. One of `memory` " +
+					"(server configuration), `database` (hosts database), `all` or `default`. Unset uses the server default. " +
+					"Changing it replaces the reservation.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"reservation_hostname": schema.StringAttribute{
 				MarkdownDescription: "Hostname to define this reservation. e.g. `switch.example.com`",
 				Required:            true,
 			},
 			"ip_address": schema.StringAttribute{
-				MarkdownDescription: "IP address for this reservation.",
+				MarkdownDescription: "IP address for this reservation. Must be inside the subnet of `subnet_id`.",
 				Required:            true,
+				CustomType:          ipAddressType,
+				Validators:          []validator.String{ipv4AddressValidator()},
 			},
 			"hw_address": schema.StringAttribute{
-				MarkdownDescription: "Hw-address/MAC address for this reservation.",
-				Required:            true,
+				MarkdownDescription: "Hw-address/MAC address for this reservation. Exactly one of `hw_address`, `client_id`, " +
+					"`duid`, `circuit_id` or `flex_id` must be specified to identify the client.",
+				Optional:   true,
+				CustomType: macAddressType,
+				Validators: []validator.String{macAddressValidator()},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -98,43 +124,58 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				MarkdownDescription: "Boot-file-name for this reservation.",
 				Optional:            true,
 			},
+			"server_hostname": schema.StringAttribute{
+				MarkdownDescription: "Server-hostname (`sname` field) for this reservation.",
+				Optional:            true,
+			},
+			"client_classes": schema.ListAttribute{
+				MarkdownDescription: "Client classes to assign to clients matching this reservation. e.g. `[\"voip\"]`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "Client-Id for this reservation.",
+				MarkdownDescription: "Client-Id (option 61) for this reservation, in hexadecimal. e.g. `01:94:8e:d3:db:d8:c5`",
 				Optional:            true,
+				CustomType:          hexIdentifierType,
+				Validators:          []validator.String{hexIdentifierValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"circuit_id": schema.StringAttribute{
-				MarkdownDescription: "Circuit-Id for this reservation.",
-				Optional:            true,
+				MarkdownDescription: "Circuit-Id (option 82, sub-option 1) inserted by the relay for this reservation, " +
+					"in hexadecimal or as a quoted string. e.g. `'gi0/0/1'`",
+				Optional:   true,
+				CustomType: hexIdentifierType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"duid": schema.StringAttribute{
-				MarkdownDescription: "Du-Id for this reservation.",
+				MarkdownDescription: "Du-Id for this reservation, in hexadecimal. e.g. `00:03:00:01:94:8e:d3:db:d8:c5`",
 				Optional:            true,
+				CustomType:          hexIdentifierType,
+				Validators:          []validator.String{hexIdentifierValidator()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"flex_id": schema.StringAttribute{
-				MarkdownDescription: "Flex-Id for this reservation.",
+				MarkdownDescription: "Flex-Id computed by the flex-id hook for this reservation, in hexadecimal or as a quoted string.",
 				Optional:            true,
+				CustomType:          hexIdentifierType,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"next_server": schema.StringAttribute{
 				MarkdownDescription: "Next-Server for this reservation.",
 				Optional:            true,
+				CustomType:          ipAddressType,
+				Validators:          []validator.String{ipv4AddressValidator()},
 			},
-			"option_data": schema.ListNestedAttribute{
-				MarkdownDescription: "List of option-data to configure on the pool. e.g. `[{code = 6, name = \"domain-name-servers\", data = \"8.8.8.8, 4.2.2.2\"}]`",
-				Optional:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"code":        schema.Int64Attribute{Required: true},
-						"name":        schema.StringAttribute{Required: true},
-						"data":        schema.StringAttribute{Required: true},
-						"always_send": schema.BoolAttribute{Required: true},
-					},
-				},
-			},
-			"user_context": schema.MapAttribute{
-				MarkdownDescription: "Arbitrary string data to tie to the subnet. e.g. `{site = \"AUS\", name = \"Austin, Tx\"}`",
-				ElementType:         types.StringType,
-				Optional:            true,
-			},
+			"option_data":  optionDataAttribute(kea.DHCP4OptionSpace),
+			"user_context": userContextAttribute("reservation"),
 		},
 	}
 }
@@ -162,9 +203,9 @@ func (r *reservationResource) Configure(_ context.Context, req resource.Configur
 func (r *reservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var config reservationResourceSchema
 
-	// Read Terraform configuration data into the model
+	// Read Terraform plan data into the model, which holds the `subnet_id` default.
 	// Also append any diagnostics to the diagnostics list.
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
 	// If the subnet value is empty, add an error to the diagnostics.
 	if config.SubnetID.IsNull() || config.SubnetID.IsUnknown() {
@@ -186,63 +227,15 @@ func (r *reservationResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("ReservationAdd", "`ip_address` field is required")
 	}
 
-	//  If the HwAddress value is empty, add an error to the diagnostics.
-	if config.HwAddress.IsNull() || config.HwAddress.IsUnknown() || config.HwAddress.ValueString() == "" {
-		resp.Diagnostics.AddError("ReservationAdd", "`hw_address` field is required")
-	}
-
 	// If there are any diagnostics, stop here.
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resv := kea.Reservation{
-		Hostname:  config.ReservationHostname.ValueString(),
-		IPAddress: config.IPAddress.ValueString(),
-		HwAddress: config.HwAddress.ValueString(),
-		SubnetID:  int(config.SubnetID.ValueInt64()),
-		OptionData: func() []kea.OptionData {
-			fr := make([]kea.OptionData, 0)
-			for _, o := range config.OptionData {
-				code := int(o.Code.ValueInt64())
-				fr = append(fr, kea.OptionData{
-					Code:       &code,
-					Name:       o.Name.ValueString(),
-					Data:       o.Data.ValueString(),
-					AlwaysSend: o.AlwaysSend.ValueBool(),
-				})
-			}
-			return fr
-		}(),
-		UserContext: func() map[string]any {
-			fr := make(map[string]any)
-			for k, v := range config.UserContext.Elements() {
-				fr[k] = v.String()
-			}
-			return fr
-		}(),
-	}
-	if !config.BootFileName.IsNull() && !config.BootFileName.IsUnknown() {
-		resv.BootFileName = config.BootFileName.ValueString()
-	}
-	if !config.ClientID.IsNull() && !config.ClientID.IsUnknown() {
-		resv.ClientID = config.ClientID.ValueString()
-	}
-	if !config.CircuitID.IsNull() && !config.CircuitID.IsUnknown() {
-		resv.CircuitID = config.CircuitID.ValueString()
-	}
-	if !config.DuID.IsNull() && !config.DuID.IsUnknown() {
-		resv.DuID = config.DuID.ValueString()
-	}
-	if !config.FlexID.IsNull() && !config.FlexID.IsUnknown() {
-		resv.FlexID = config.FlexID.ValueString()
-	}
-	if !config.NextServer.IsNull() && !config.NextServer.IsUnknown() {
-		resv.NextServer = config.NextServer.ValueString()
-	}
+	resv := reservationFromSchema(config)
 
 	// nolint: contextcheck
-	if err := r.client.ReservationAdd(config.Hostname.ValueString(), resv); err != nil {
+	if err := r.client.ReservationAdd(config.Hostname.ValueString(), resv, kea.WithOperationTarget(config.OperationTarget.ValueString())); err != nil {
 		resp.Diagnostics.AddError(
 			"ReservationAdd",
 			fmt.Sprintf("Unable to create reservation in Kea, got error: %s | %v", err, resv),
@@ -255,6 +248,7 @@ func (r *reservationResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
 
@@ -275,9 +269,10 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 		resp.Diagnostics.AddError("ReservationAdd", "`hostname` field is required")
 	}
 
-	//  If the ReservationHostname value is empty, add an error to the diagnostics.
-	if config.ReservationHostname.IsNull() || config.ReservationHostname.IsUnknown() || config.ReservationHostname.ValueString() == "" {
-		resp.Diagnostics.AddError("ReservationAdd", "`reservation_hostname` field is required")
+	//  An imported reservation only knows either its IP address or its hw-address.
+	identifierType, identifier := reservationIdentifier(config)
+	if identifier == "" {
+		resp.Diagnostics.AddError("ReservationGet", "`ip_address` or an identifier field is required")
 	}
 
 	// If there are any diagnostics errors, stop here.
@@ -288,13 +283,10 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 	// nolint: contextcheck
 	respData, err := r.client.ReservationGet(
 		config.Hostname.ValueString(),
-		func() string {
-			if !config.IPAddress.IsNull() && !config.IPAddress.IsUnknown() && config.IPAddress.ValueString() != "" {
-				return config.IPAddress.ValueString()
-			}
-			return config.HwAddress.ValueString()
-		}(),
+		identifierType,
+		identifier,
 		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
 	)
 	if err != nil {
 		// Only return an error if the error is NOT subnet not found.
@@ -307,6 +299,12 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 	}
 
+	// The reservation was removed outside of Terraform, or never existed when importing.
+	if respData == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// If there are any diagnostics errors, stop here.
 	if resp.Diagnostics.HasError() {
 		return
@@ -314,56 +312,7 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Marshalling the response data taken from Kea, and write
 	// it into the TF Subnets model.
-	config.ReservationHostname = types.StringValue(respData.Hostname)
-	config.IPAddress = types.StringValue(respData.IPAddress)
-	config.HwAddress = types.StringValue(respData.HwAddress)
-
-	if respData.NextServer != "0.0.0.0" {
-		config.NextServer = types.StringValue(respData.NextServer)
-	}
-	if respData.BootFileName != "" {
-		config.BootFileName = types.StringValue(respData.BootFileName)
-	}
-	if respData.ClientID != "" {
-		config.ClientID = types.StringValue(respData.ClientID)
-	}
-	if respData.CircuitID != "" {
-		config.CircuitID = types.StringValue(respData.CircuitID)
-	}
-	if respData.DuID != "" {
-		config.DuID = types.StringValue(respData.DuID)
-	}
-	if respData.FlexID != "" {
-		config.FlexID = types.StringValue(respData.FlexID)
-	}
-
-	config.OptionData = func() []reservationOptionResourceModel {
-		r := make([]reservationOptionResourceModel, 0)
-		for _, v := range respData.OptionData {
-			code := 0
-			if v.Code != nil {
-				code = *v.Code
-			}
-			r = append(r, reservationOptionResourceModel{
-				Code:       types.Int64Value(int64(code)),
-				Data:       types.StringValue(v.Data),
-				Name:       types.StringValue(v.Name),
-				AlwaysSend: types.BoolValue(v.AlwaysSend),
-			})
-		}
-		return r
-	}()
-	if respData.UserContext != nil {
-		config.UserContext = func() types.Map {
-			fr := make(map[string]attr.Value)
-			for k, v := range respData.UserContext {
-				fr[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-			mv, diags := types.MapValue(types.StringType, fr)
-			resp.Diagnostics.Append(diags...)
-			return mv
-		}()
-	}
+	reservationToSchema(&config, respData, &resp.Diagnostics)
 
 	// If there are any diagnostics errors, stop here.
 	if resp.Diagnostics.HasError() {
@@ -401,63 +350,15 @@ func (r *reservationResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("ReservationUpdate", "`ip_address` field is required")
 	}
 
-	//  If the HwAddress value is empty, add an error to the diagnostics.
-	if config.HwAddress.IsNull() || config.HwAddress.IsUnknown() || config.HwAddress.ValueString() == "" {
-		resp.Diagnostics.AddError("ReservationUpdate", "`hw_address` field is required")
-	}
-
 	// If there are any diagnostics, stop here.
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resv := kea.Reservation{
-		Hostname:  config.ReservationHostname.ValueString(),
-		IPAddress: config.IPAddress.ValueString(),
-		HwAddress: config.HwAddress.ValueString(),
-		SubnetID:  int(config.SubnetID.ValueInt64()),
-		OptionData: func() []kea.OptionData {
-			fr := make([]kea.OptionData, 0)
-			for _, o := range config.OptionData {
-				code := int(o.Code.ValueInt64())
-				fr = append(fr, kea.OptionData{
-					Code:       &code,
-					Name:       o.Name.ValueString(),
-					Data:       o.Data.ValueString(),
-					AlwaysSend: o.AlwaysSend.ValueBool(),
-				})
-			}
-			return fr
-		}(),
-		UserContext: func() map[string]any {
-			fr := make(map[string]any)
-			for k, v := range config.UserContext.Elements() {
-				fr[k] = v.String()
-			}
-			return fr
-		}(),
-	}
-	if !config.BootFileName.IsNull() && !config.BootFileName.IsUnknown() {
-		resv.BootFileName = config.BootFileName.ValueString()
-	}
-	if !config.ClientID.IsNull() && !config.ClientID.IsUnknown() {
-		resv.ClientID = config.ClientID.ValueString()
-	}
-	if !config.CircuitID.IsNull() && !config.CircuitID.IsUnknown() {
-		resv.CircuitID = config.CircuitID.ValueString()
-	}
-	if !config.DuID.IsNull() && !config.DuID.IsUnknown() {
-		resv.DuID = config.DuID.ValueString()
-	}
-	if !config.FlexID.IsNull() && !config.FlexID.IsUnknown() {
-		resv.FlexID = config.FlexID.ValueString()
-	}
-	if !config.NextServer.IsNull() && !config.NextServer.IsUnknown() {
-		resv.NextServer = config.NextServer.ValueString()
-	}
+	resv := reservationFromSchema(config)
 
 	// nolint: contextcheck
-	if err := r.client.ReservationUpdate(config.Hostname.ValueString(), resv); err != nil {
+	if err := r.client.ReservationUpdate(config.Hostname.ValueString(), resv, kea.WithOperationTarget(config.OperationTarget.ValueString())); err != nil {
 		resp.Diagnostics.AddError(
 			"ReservationUpdate",
 			fmt.Sprintf("Unable to update reservation in Kea, got error: %s | %v", err, resv),
@@ -466,6 +367,7 @@ func (r *reservationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Save updated data into Terraform state
+	optionDataResolveUnknown(config.OptionData)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
 
@@ -501,8 +403,16 @@ func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	identifierType, identifier := reservationIdentifier(config)
+
 	// nolint: contextcheck
-	if err := r.client.ReservationDel(config.Hostname.ValueString(), config.IPAddress.ValueString(), int(config.SubnetID.ValueInt64())); err != nil {
+	if err := r.client.ReservationDel(
+		config.Hostname.ValueString(),
+		identifierType,
+		identifier,
+		int(config.SubnetID.ValueInt64()),
+		kea.WithOperationTarget(config.OperationTarget.ValueString()),
+	); err != nil {
 		resp.Diagnostics.AddError(
 			"ReservationDel",
 			fmt.Sprintf("Unable to delete reservation, got error: %s", err),
@@ -512,6 +422,195 @@ func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 // ImportState : Imports an existing resource by a unique identifier.
+// The import ID is `hostname/subnet_id/ip-or-mac` or `hostname/subnet_id/identifier-type=identifier`,
+// e.g. `kea.example.com/1921682300/192.168.230.10` or `kea.example.com/1921682300/client-id=01:94:8e:d3:db:d8:c5`.
 func (r *reservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("subnet"), req, resp)
+	hostname, subnetID, identifierType, identifier, err := parseReservationImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), hostname)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet_id"), int64(subnetID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(strings.ReplaceAll(identifierType, "-", "_")), identifier)...)
+}
+
+// UpgradeState : Upgrades the state of prior schema versions.
+func (r *reservationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {PriorSchema: reservationSchemaV0(), StateUpgrader: upgradeReservationStateV0},
+		1: {StateUpgrader: upgradeUserContextState},
+	}
+}
+
+// ValidateConfig : Validates the operation target, that option-data codes are unique per space and standard
+// options carry valid data, and that the reservation is identified by exactly one identifier.
+func (r *reservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config reservationResourceSchema
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.OperationTarget; !v.IsNull() && !v.IsUnknown() && !slices.Contains(kea.OperationTargets, v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("operation_target"),
+			"Invalid Operation Target",
+			fmt.Sprintf("`operation_target` must be one of %s, got `%s`.", strings.Join(kea.OperationTargets, ", "), v.ValueString()),
+		)
+	}
+
+	validateOptionData(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, &resp.Diagnostics)
+	optionDataResolve(path.Root("option_data"), config.OptionData, kea.DHCP4OptionSpace, standardOptionDef4, &resp.Diagnostics)
+
+	set := make([]string, 0)
+	for name, v := range map[string]types.String{
+		"hw_address": config.HwAddress.StringValue,
+		"client_id":  config.ClientID.StringValue,
+		"duid":       config.DuID.StringValue,
+		"circuit_id": config.CircuitID.StringValue,
+		"flex_id":    config.FlexID.StringValue,
+	} {
+		// An unknown identifier can't be validated until apply.
+		if v.IsUnknown() {
+			return
+		}
+		if !v.IsNull() && v.ValueString() != "" {
+			set = append(set, "`"+name+"`")
+		}
+	}
+
+	switch len(set) {
+	case 1:
+	case 0:
+		resp.Diagnostics.AddError(
+			"Missing Reservation Identifier",
+			"Exactly one of `hw_address`, `client_id`, `duid`, `circuit_id` or `flex_id` must be specified.",
+		)
+	default:
+		sort.Strings(set)
+		resp.Diagnostics.AddError(
+			"Conflicting Reservation Identifiers",
+			fmt.Sprintf("Exactly one of `hw_address`, `client_id`, `duid`, `circuit_id` or `flex_id` must be specified, got %s.", strings.Join(set, ", ")),
+		)
+	}
+}
+
+// ModifyPlan : Fills in the option_data codes and names from the option definitions, and checks that the
+// reserved IP address is inside the subnet of `subnet_id`. The subnet prefix is only known to Kea, so this
+// runs at plan time rather than in ValidateConfig, and only when the address or subnet changes. Subnets
+// that can't be looked up yet, e.g. created in the same plan, are left to Kea.
+func (r *reservationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	planOptionData4(ctx, r.client, req, resp)
+
+	// The subnet check needs Kea, so it waits for the provider to be configured.
+	if r.client == nil {
+		return
+	}
+
+	var hostname, ipAddress types.String
+	var subnetID types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hostname"), &hostname)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("subnet_id"), &subnetID)...)
+	if resp.Diagnostics.HasError() || hostname.IsUnknown() || ipAddress.IsUnknown() || ipAddress.IsNull() ||
+		subnetID.IsUnknown() || subnetID.ValueInt64() == kea.GlobalSubnetID {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var priorIP types.String
+		var priorSubnetID types.Int64
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ip_address"), &priorIP)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("subnet_id"), &priorSubnetID)...)
+		if priorIP.Equal(ipAddress) && priorSubnetID.Equal(subnetID) {
+			return
+		}
+	}
+
+	// nolint: contextcheck
+	subnet, err := r.client.RemoteSubnet4GetByID(hostname.ValueString(), int(subnetID.ValueInt64()))
+	if err != nil {
+		tflog.Debug(ctx, "skipping the reservation subnet check", map[string]any{"subnet_id": subnetID.ValueInt64(), "error": err.Error()})
+		return
+	}
+
+	ip := net.ParseIP(ipAddress.ValueString())
+	if _, network, err := net.ParseCIDR(subnet.Subnet); err == nil && ip != nil && !network.Contains(ip) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Reservation Outside Subnet",
+			fmt.Sprintf("`%s` is not inside subnet %d (`%s`).", ipAddress.ValueString(), subnetID.ValueInt64(), subnet.Subnet),
+		)
+	}
+}
+
+// reservationIdentifier : Returns the identifier type and identifier to address the reservation
+// with in Kea, falling back to the reserved IP address when no identifier is known, e.g. on import.
+func reservationIdentifier(config reservationResourceSchema) (string, string) {
+	for _, v := range []struct {
+		identifierType string
+		value          types.String
+	}{
+		{kea.IdentifierHwAddress, config.HwAddress.StringValue},
+		{kea.IdentifierClientID, config.ClientID.StringValue},
+		{kea.IdentifierDUID, config.DuID.StringValue},
+		{kea.IdentifierCircuitID, config.CircuitID.StringValue},
+		{kea.IdentifierFlexID, config.FlexID.StringValue},
+	} {
+		if v.value.ValueString() != "" {
+			return v.identifierType, v.value.ValueString()
+		}
+	}
+	return kea.IdentifierIPAddress, config.IPAddress.ValueString()
+}
+
+// reservationFromSchema : Converts the resource model into a Kea reservation.
+func reservationFromSchema(config reservationResourceSchema) kea.Reservation {
+	resv := kea.Reservation{
+		Hostname:       config.ReservationHostname.ValueString(),
+		IPAddress:      config.IPAddress.ValueString(),
+		HwAddress:      config.HwAddress.ValueString(),
+		SubnetID:       int(config.SubnetID.ValueInt64()),
+		BootFileName:   config.BootFileName.ValueString(),
+		ClientID:       config.ClientID.ValueString(),
+		CircuitID:      config.CircuitID.ValueString(),
+		DuID:           config.DuID.ValueString(),
+		FlexID:         config.FlexID.ValueString(),
+		NextServer:     config.NextServer.ValueString(),
+		ServerHostname: config.ServerHostname.ValueString(),
+		ClientClasses:  stringListElements(config.ClientClasses),
+		OptionData:     optionDataFromSchema(config.OptionData),
+		UserContext:    userContextFromSchema(config.UserContext),
+	}
+	return resv
+}
+
+// reservationToSchema : Writes every field of the Kea reservation into the resource model. Fields
+// that Kea reports as unset become null, unless the prior model holds an equivalent empty value,
+// so that drift is detected on every field without diffs between null and empty values.
+func reservationToSchema(config *reservationResourceSchema, res *kea.Reservation, diags *diag.Diagnostics) {
+	config.SubnetID = types.Int64Value(int64(res.SubnetID))
+	config.ReservationHostname = types.StringValue(res.Hostname)
+	config.IPAddress = ipAddressType.value(res.IPAddress)
+	config.HwAddress = macAddressType.valueOrNull(res.HwAddress)
+	config.ClientID = hexIdentifierType.valueOrNull(res.ClientID)
+	config.CircuitID = hexIdentifierType.valueOrNull(res.CircuitID)
+	config.DuID = hexIdentifierType.valueOrNull(res.DuID)
+	config.FlexID = hexIdentifierType.valueOrNull(res.FlexID)
+	config.BootFileName = stringValueOrNull(res.BootFileName)
+	config.ServerHostname = stringValueOrNull(res.ServerHostname)
+	config.NextServer = ipAddressType.valueOrNull(res.NextServer)
+	if res.NextServer == "0.0.0.0" {
+		config.NextServer = ipAddressType.valueOrNull("")
+	}
+	config.ClientClasses = stringListValue(config.ClientClasses, res.ClientClasses, diags)
+
+	config.OptionData = optionDataToSchema(config.OptionData, res.OptionData, kea.DHCP4OptionSpace)
+	config.UserContext = userContextToSchema(config.UserContext, res.UserContext, diags)
 }
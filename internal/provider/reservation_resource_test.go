@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestReservationSchemaRoundTrip(t *testing.T) {
+	code := 66
+	space := kea.DHCP4OptionSpace
+	csv := false
+	never := true
+	want := kea.Reservation{
+		BootFileName:   "pxelinux.0",
+		ClientClasses:  []string{"voip", "phones"},
+		CircuitID:      "6769302F302F31",
+		IPAddress:      "192.168.230.10",
+		Hostname:       "phone.example.com",
+		NextServer:     "192.168.230.2",
+		ServerHostname: "tftp.example.com",
+		SubnetID:       1921682300,
+		OptionData: []kea.OptionData{
+			{Code: &code, Name: "tftp-server-name", Data: "746674702e6578616d706c652e636f6d", Space: &space, CSVFormat: &csv, NeverSend: &never},
+		},
+		UserContext: map[string]any{"site": "AUS"},
+	}
+
+	var diags diag.Diagnostics
+	config := reservationResourceSchema{
+		ClientClasses: types.ListNull(types.StringType),
+		UserContext:   jsonObjectValue{StringValue: types.StringNull()},
+	}
+	reservationToSchema(&config, &want, &diags)
+	if diags.HasError() {
+		t.Fatalf("reservationToSchema() diagnostics: %v", diags)
+	}
+
+	got := reservationFromSchema(config)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("reservation did not round-trip:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestReservationToSchemaKeepsEmptyValues(t *testing.T) {
+	var diags diag.Diagnostics
+	emptyList, _ := types.ListValue(types.StringType, []attr.Value{})
+	config := reservationResourceSchema{
+		ClientClasses: emptyList,
+		OptionData:    []optionDataResourceModel{},
+		UserContext:   jsonObjectValue{StringValue: types.StringNull()},
+	}
+	reservationToSchema(&config, &kea.Reservation{IPAddress: "192.168.230.10", HwAddress: "94:8e:d3:db:d8:c5", NextServer: "0.0.0.0"}, &diags)
+
+	if config.ClientClasses.IsNull() || len(config.ClientClasses.Elements()) != 0 {
+		t.Errorf("client_classes = %v, want an empty list", config.ClientClasses)
+	}
+	if config.OptionData == nil {
+		t.Error("option_data = nil, want an empty list")
+	}
+	if !config.UserContext.IsNull() {
+		t.Errorf("user_context = %v, want null", config.UserContext)
+	}
+	if !config.NextServer.IsNull() || !config.ClientID.IsNull() {
+		t.Errorf("next_server = %v, client_id = %v, want null", config.NextServer, config.ClientID)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &reservationSearchDataSource{}
+	_ datasource.DataSourceWithConfigure = &reservationSearchDataSource{}
+)
+
+// NewReservationSearchDataSource : Creates a new empty data source client.
+func NewReservationSearchDataSource() datasource.DataSource {
+	return &reservationSearchDataSource{}
+}
+
+type (
+	// reservationSearchDataSource defines the data source client.
+	reservationSearchDataSource struct {
+		client *kea.Client
+	}
+
+	// reservationSearchDataSourceSchema describes the data source data model.
+	// Maps to the source schema data.
+	reservationSearchDataSourceSchema struct {
+		Hostname            types.String           `tfsdk:"hostname"`
+		ReservationHostname types.String           `tfsdk:"reservation_hostname"`
+		SubnetID            types.Int64            `tfsdk:"subnet_id"`
+		IdentifierType      types.String           `tfsdk:"identifier_type"`
+		Identifier          types.String           `tfsdk:"identifier"`
+		IPAddress           types.String           `tfsdk:"ip_address"`
+		OperationTarget     types.String           `tfsdk:"operation_target"`
+		Reservations        []reservationListModel `tfsdk:"reservations"`
+	}
+)
+
+// Metadata : Defines the data source metadata.
+func (d *reservationSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation_search_data_source"
+}
+
+// Schema : Defines the data source schema.
+func (d *reservationSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reservation search data source. Finds reservations by `reservation_hostname`, " +
+			"by `identifier_type` and `identifier`, or by `ip_address`, across every subnet including the global reservations.",
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"reservation_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the reservations to search for. e.g. `switch.example.com`. Conflicts with `identifier` and `ip_address`.",
+				Optional:            true,
+			},
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "Restricts the `reservation_hostname` or `ip_address` search to a single subnet, `0` for the global reservations. e.g. `1921682300`",
+				Optional:            true,
+			},
+			"identifier_type": schema.StringAttribute{
+				MarkdownDescription: "Type of `identifier`. One of `hw-address`, `client-id`, `duid`, `circuit-id` or `flex-id`.",
+				Optional:            true,
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Host identifier of the reservations to search for, of the type `identifier_type`. " +
+					"e.g. `94:8e:d3:db:d8:c5`. Conflicts with `reservation_hostname` and `ip_address`.",
+				Optional: true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "Reserved IP address of the reservations to search for, using `reservation-get-by-address`. " +
+					"e.g. `192.168.230.50`. Conflicts with `reservation_hostname` and `identifier`.",
+				Optional: true,
+			},
+			"operation_target": schema.StringAttribute{
+				MarkdownDescription: "Kea 2.4+ `operation-target` of the search. One of `memory`, `database`, `all` or `default`.",
+				Optional:            true,
+			},
+			"reservations": reservationListAttribute("Every reservation matching the search."),
+		},
+	}
+}
+
+// Configure : Configures the data source client.
+func (d *reservationSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read : Reads the data source data into the Terraform state.
+func (d *reservationSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Define an empty configuration.
+	var config reservationSearchDataSourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Validate that a `hostname` is specified.
+	if config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"A `hostname` must be specified. DNS name or IP address of the Kea DHCP server.",
+		)
+	}
+
+	// Validate that only one of `reservation_hostname`, `identifier` or `ip_address` is specified.
+	searches := 0
+	for _, v := range []types.String{config.ReservationHostname, config.Identifier, config.IPAddress} {
+		if !v.IsNull() {
+			searches++
+		}
+	}
+	if searches != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of `reservation_hostname`, `identifier` or `ip_address` must be specified.",
+		)
+	}
+
+	// Validate that `identifier` is always specified with its type.
+	if config.Identifier.IsNull() != config.IdentifierType.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"`identifier` and `identifier_type` must be specified together.",
+		)
+	}
+
+	// Validate that `subnet_id` is only used to restrict a hostname or address search.
+	if !config.SubnetID.IsNull() && !config.Identifier.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"`subnet_id` can only be specified with `reservation_hostname` or `ip_address`, identifiers are searched in every subnet.",
+		)
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnetID := -1
+	if !config.SubnetID.IsNull() {
+		subnetID = int(config.SubnetID.ValueInt64())
+	}
+	target := kea.WithOperationTarget(config.OperationTarget.ValueString())
+
+	var (
+		respData []kea.Reservation
+		err      error
+	)
+	switch {
+	case !config.ReservationHostname.IsNull():
+		// nolint: contextcheck
+		respData, err = d.client.ReservationGetByHostname(config.Hostname.ValueString(), config.ReservationHostname.ValueString(), subnetID, target)
+	case !config.IPAddress.IsNull():
+		// nolint: contextcheck
+		respData, err = d.client.ReservationGetByAddress(config.Hostname.ValueString(), config.IPAddress.ValueString(), subnetID, target)
+	default:
+		// nolint: contextcheck
+		respData, err = d.client.ReservationGetByID(config.Hostname.ValueString(), config.IdentifierType.ValueString(), config.Identifier.ValueString(), target)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"ReservationSearch",
+			fmt.Sprintf("Unable to search the reservations, got error: %s", err),
+		)
+		return
+	}
+
+	config.Reservations = reservationListModels(respData, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
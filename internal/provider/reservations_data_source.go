@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// defaultReservationPageSize : Number of reservations fetched per reservation-get-page command.
+const defaultReservationPageSize = 100
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ datasource.DataSource              = &reservationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &reservationsDataSource{}
+)
+
+// NewReservationsDataSource : Creates a new empty data source client.
+func NewReservationsDataSource() datasource.DataSource {
+	return &reservationsDataSource{}
+}
+
+type (
+	// reservationsDataSource defines the data source client.
+	reservationsDataSource struct {
+		client *kea.Client
+	}
+
+	// reservationsDataSourceSchema describes the data source data model.
+	// Maps to the source schema data.
+	reservationsDataSourceSchema struct {
+		Hostname     types.String           `tfsdk:"hostname"`
+		SubnetID     types.Int64            `tfsdk:"subnet_id"`
+		PageSize     types.Int64            `tfsdk:"page_size"`
+		Reservations []reservationListModel `tfsdk:"reservations"`
+	}
+
+	// reservationListModel : Represents a single reservation in a list of reservations.
+	reservationListModel struct {
+		SubnetID            types.Int64                        `tfsdk:"subnet_id"`
+		ReservationHostname types.String                       `tfsdk:"reservation_hostname"`
+		BootFileName        types.String                       `tfsdk:"boot_file_name"`
+		ClientClasses       []types.String                     `tfsdk:"client_classes"`
+		ServerHostname      types.String                       `tfsdk:"server_hostname"`
+		ClientID            types.String                       `tfsdk:"client_id"`
+		CircuitID           types.String                       `tfsdk:"circuit_id"`
+		DuID                types.String                       `tfsdk:"duid"`
+		FlexID              types.String                       `tfsdk:"flex_id"`
+		IPAddress           types.String                       `tfsdk:"ip_address"`
+		HwAddress           types.String                       `tfsdk:"hw_address"`
+		NextServer          types.String                       `tfsdk:"next_server"`
+		OptionData          []reservationDataSourceOptionModel `tfsdk:"option_data"`
+		UserContext         jsonObjectValue                    `tfsdk:"user_context"`
+	}
+)
+
+// Metadata : Defines the data source metadata.
+func (d *reservationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservations_data_source"
+}
+
+// Schema : Defines the data source schema.
+func (d *reservationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reservations data source, lists every reservation in a subnet. Reservations are fetched " +
+			"in pages with `reservation-get-page`, so large subnets can be listed.",
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the kea server to connect to. e.g. `kea.example.com`",
+				Required:            true,
+			},
+			"subnet_id": schema.Int64Attribute{
+				MarkdownDescription: "Subnet4 ID to fetch the reservations from. e.g. `1921682300`. Defaults to `0`, the global reservations.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of reservations to fetch per command. Defaults to `%d`.", defaultReservationPageSize),
+				Optional:            true,
+			},
+			"reservations": reservationListAttribute("Every reservation in the subnet."),
+		},
+	}
+}
+
+// Configure : Configures the data source client.
+func (d *reservationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Fetch the Kea DHCP client from the provider.
+	client, ok := req.ProviderData.(*kea.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *kea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read : Reads the data source data into the Terraform state.
+func (d *reservationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Define an empty configuration.
+	var config reservationsDataSourceSchema
+
+	// Read Terraform configuration data into the model
+	// Also append any diagnostics to the diagnostics list.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	// Validate that a `hostname` is specified.
+	if config.Hostname.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"A `hostname` must be specified. DNS name or IP address of the Kea DHCP server.",
+		)
+	}
+
+	pageSize := defaultReservationPageSize
+	if !config.PageSize.IsNull() {
+		pageSize = int(config.PageSize.ValueInt64())
+	}
+	if pageSize < 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			fmt.Sprintf("`page_size` must be a positive number, got %d.", pageSize),
+		)
+	}
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SubnetID.IsNull() {
+		config.SubnetID = types.Int64Value(kea.GlobalSubnetID)
+	}
+
+	// nolint: contextcheck
+	respData, err := d.client.ReservationList(config.Hostname.ValueString(), int(config.SubnetID.ValueInt64()), pageSize)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"ReservationGetPage",
+			fmt.Sprintf("Unable to list the reservations of subnet %d, got error: %s", config.SubnetID.ValueInt64(), err),
+		)
+		return
+	}
+
+	config.Reservations = reservationListModels(respData, &resp.Diagnostics)
+
+	// If there are any diagnostics errors, stop here.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// reservationListAttribute : Returns the schema of a computed list of reservations.
+func reservationListAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"subnet_id":            schema.Int64Attribute{Computed: true},
+				"reservation_hostname": schema.StringAttribute{Computed: true},
+				"boot_file_name":       schema.StringAttribute{Computed: true},
+				"client_classes":       schema.ListAttribute{ElementType: types.StringType, Computed: true},
+				"server_hostname":      schema.StringAttribute{Computed: true},
+				"client_id":            schema.StringAttribute{Computed: true},
+				"circuit_id":           schema.StringAttribute{Computed: true},
+				"duid":                 schema.StringAttribute{Computed: true},
+				"flex_id":              schema.StringAttribute{Computed: true},
+				"ip_address":           schema.StringAttribute{Computed: true},
+				"hw_address":           schema.StringAttribute{Computed: true},
+				"next_server":          schema.StringAttribute{Computed: true},
+				"option_data": schema.ListNestedAttribute{
+					Computed: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"code":        schema.Int64Attribute{Computed: true},
+							"name":        schema.StringAttribute{Computed: true},
+							"data":        schema.StringAttribute{Computed: true},
+							"always_send": schema.BoolAttribute{Computed: true},
+							"space":       schema.StringAttribute{Computed: true},
+							"csv_format":  schema.BoolAttribute{Computed: true},
+							"never_send":  schema.BoolAttribute{Computed: true},
+						},
+					},
+				},
+				"user_context": schema.StringAttribute{
+					MarkdownDescription: "Arbitrary data tied to the reservation, as a JSON object.",
+					Computed:            true,
+					CustomType:          jsonObjectType{},
+				},
+			},
+		},
+	}
+}
+
+// reservationListModels : Converts Kea reservations into the list model of reservationListAttribute.
+func reservationListModels(hosts []kea.Reservation, diags *diag.Diagnostics) []reservationListModel {
+	ret := make([]reservationListModel, 0, len(hosts))
+	for _, h := range hosts {
+		m := reservationListModel{
+			SubnetID:            types.Int64Value(int64(h.SubnetID)),
+			ReservationHostname: types.StringValue(h.Hostname),
+			BootFileName:        types.StringValue(h.BootFileName),
+			ClientClasses:       stringValues(h.ClientClasses),
+			ServerHostname:      types.StringValue(h.ServerHostname),
+			ClientID:            types.StringValue(h.ClientID),
+			CircuitID:           types.StringValue(h.CircuitID),
+			DuID:                types.StringValue(h.DuID),
+			FlexID:              types.StringValue(h.FlexID),
+			IPAddress:           types.StringValue(h.IPAddress),
+			HwAddress:           types.StringValue(h.HwAddress),
+			NextServer:          types.StringValue(h.NextServer),
+			OptionData:          reservationDataSourceOptionModels(h.OptionData, kea.DHCP4OptionSpace),
+			UserContext:         userContextToSchema(jsonObjectValue{StringValue: types.StringNull()}, h.UserContext, diags),
+		}
+		ret = append(ret, m)
+	}
+	return ret
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccReservationsDataSourceConfig = fmt.Sprintf(`
+data "kea_reservations_data_source" "test" {
+  hostname  = "%s"
+  subnet_id = 1921682300
+  page_size = 2
+}`, testAccHostname)
+
+func TestAccReservationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccReservationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kea_reservations_data_source.test", "hostname", testAccHostname),
+					resource.TestCheckResourceAttrSet("data.kea_reservations_data_source.test", "reservations.#"),
+				),
+			},
+		},
+	})
+}
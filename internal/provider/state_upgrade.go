@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+type (
+	// remoteSubnet4ResourceSchemaV0 : The remote subnet4 data model before `pools`, `relay` and
+	// `option_data` became sets.
+	remoteSubnet4ResourceSchemaV0 struct {
+		Hostname       types.String `tfsdk:"hostname"`
+		ID             types.Int64  `tfsdk:"id"`
+		SubnetID       types.Int64  `tfsdk:"subnet_id"`
+		SubnetIDStrat  types.String `tfsdk:"subnet_id_strategy"`
+		OptionData     types.List   `tfsdk:"option_data"`
+		Pools          types.List   `tfsdk:"pools"`
+		Relay          types.List   `tfsdk:"relay"`
+		Subnet         types.String `tfsdk:"subnet"`
+		NextServer     types.String `tfsdk:"next_server"`
+		ServerHostname types.String `tfsdk:"server_hostname"`
+		BootFileName   types.String `tfsdk:"boot_file_name"`
+		UserContext    types.Map    `tfsdk:"user_context"`
+	}
+
+	// remoteSubnet4OptionResourceModelV0 : A remote subnet4 option-data entry before it gained `space`,
+	// `csv_format` and `never_send`.
+	remoteSubnet4OptionResourceModelV0 struct {
+		Code       types.Int64  `tfsdk:"code"`
+		Data       types.String `tfsdk:"data"`
+		Name       types.String `tfsdk:"name"`
+		AlwaysSend types.Bool   `tfsdk:"always_send"`
+	}
+
+	// reservationResourceSchemaV0 : The reservation data model before `option_data` became a set.
+	reservationResourceSchemaV0 struct {
+		SubnetID            types.Int64  `tfsdk:"subnet_id"`
+		OperationTarget     types.String `tfsdk:"operation_target"`
+		Hostname            types.String `tfsdk:"hostname"`
+		ReservationHostname types.String `tfsdk:"reservation_hostname"`
+		BootFileName        types.String `tfsdk:"boot_file_name"`
+		ClientClasses       types.List   `tfsdk:"client_classes"`
+		ServerHostname      types.String `tfsdk:"server_hostname"`
+		ClientID            types.String `tfsdk:"client_id"`
+		CircuitID           types.String `tfsdk:"circuit_id"`
+		DuID                types.String `tfsdk:"duid"`
+		FlexID              types.String `tfsdk:"flex_id"`
+		IPAddress           types.String `tfsdk:"ip_address"`
+		HwAddress           types.String `tfsdk:"hw_address"`
+		NextServer          types.String `tfsdk:"next_server"`
+		OptionData          types.List   `tfsdk:"option_data"`
+		UserContext         types.Map    `tfsdk:"user_context"`
+	}
+)
+
+// remoteSubnet4SchemaV0 : The remote subnet4 schema before `pools`, `relay` and `option_data` became sets.
+func remoteSubnet4SchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname":           schema.StringAttribute{Required: true},
+			"id":                 schema.Int64Attribute{Computed: true},
+			"subnet_id":          schema.Int64Attribute{Optional: true, Computed: true},
+			"subnet_id_strategy": schema.StringAttribute{Optional: true},
+			"subnet":             schema.StringAttribute{Required: true},
+			"pools": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pool": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"relay": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"option_data": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code":        schema.Int64Attribute{Required: true},
+						"name":        schema.StringAttribute{Required: true},
+						"data":        schema.StringAttribute{Required: true},
+						"always_send": schema.BoolAttribute{Required: true},
+					},
+				},
+			},
+			"user_context":    schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"next_server":     schema.StringAttribute{Optional: true},
+			"server_hostname": schema.StringAttribute{Optional: true},
+			"boot_file_name":  schema.StringAttribute{Optional: true},
+		},
+	}
+}
+
+// reservationSchemaV0 : The reservation schema before `option_data` became a set.
+func reservationSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname":             schema.StringAttribute{Required: true},
+			"subnet_id":            schema.Int64Attribute{Optional: true, Computed: true},
+			"operation_target":     schema.StringAttribute{Optional: true},
+			"reservation_hostname": schema.StringAttribute{Required: true},
+			"ip_address":           schema.StringAttribute{Required: true},
+			"hw_address":           schema.StringAttribute{Optional: true},
+			"boot_file_name":       schema.StringAttribute{Optional: true},
+			"server_hostname":      schema.StringAttribute{Optional: true},
+			"client_classes":       schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"client_id":            schema.StringAttribute{Optional: true},
+			"circuit_id":           schema.StringAttribute{Optional: true},
+			"duid":                 schema.StringAttribute{Optional: true},
+			"flex_id":              schema.StringAttribute{Optional: true},
+			"next_server":          schema.StringAttribute{Optional: true},
+			"option_data": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code":        schema.Int64Attribute{Required: true},
+						"name":        schema.StringAttribute{Required: true},
+						"data":        schema.StringAttribute{Required: true},
+						"always_send": schema.BoolAttribute{Required: true},
+						"space":       schema.StringAttribute{Optional: true, Computed: true},
+						"csv_format":  schema.BoolAttribute{Optional: true, Computed: true},
+						"never_send":  schema.BoolAttribute{Optional: true, Computed: true},
+					},
+				},
+			},
+			"user_context": schema.MapAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+}
+
+// upgradeRemoteSubnet4StateV0 : Moves the `pools`, `relay` and `option_data` lists into sets, with the
+// option-data defaults filled in, and encodes `user_context` as a JSON object.
+func upgradeRemoteSubnet4StateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior remoteSubnet4ResourceSchemaV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := remoteSubnet4ResourceSchema{
+		Hostname:       prior.Hostname,
+		ID:             prior.ID,
+		SubnetID:       prior.SubnetID,
+		SubnetIDStrat:  prior.SubnetIDStrat,
+		Subnet:         prefixType.valueFrom(prior.Subnet),
+		NextServer:     ipAddressType.valueFrom(prior.NextServer),
+		ServerHostname: prior.ServerHostname,
+		BootFileName:   prior.BootFileName,
+		UserContext:    userContextFromMapV0(ctx, prior.UserContext, &resp.Diagnostics),
+		Merge:          types.BoolValue(false),
+	}
+	var opts []remoteSubnet4OptionResourceModelV0
+	resp.Diagnostics.Append(prior.OptionData.ElementsAs(ctx, &opts, false)...)
+	for _, o := range opts {
+		state.OptionData = append(state.OptionData, optionDataResourceModel{
+			Code:       o.Code,
+			Data:       o.Data,
+			Name:       o.Name,
+			AlwaysSend: o.AlwaysSend,
+			Space:      types.StringValue(kea.DHCP4OptionSpace),
+			CSVFormat:  types.BoolValue(true),
+			NeverSend:  types.BoolValue(false),
+		})
+	}
+
+	var pools []struct {
+		Pool types.String `tfsdk:"pool"`
+	}
+	resp.Diagnostics.Append(prior.Pools.ElementsAs(ctx, &pools, false)...)
+	for _, p := range pools {
+		state.Pools = append(state.Pools, pool4ToSchema(nil, kea.Pool{Pool: p.Pool.ValueString()}, &resp.Diagnostics))
+	}
+
+	var relays []struct {
+		IPAddress types.String `tfsdk:"ip_address"`
+	}
+	resp.Diagnostics.Append(prior.Relay.ElementsAs(ctx, &relays, false)...)
+	for _, r := range relays {
+		state.Relay = append(state.Relay, remoteSubnet4RelayResourceModel{IPAddress: ipAddressType.valueFrom(r.IPAddress)})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// upgradeReservationStateV0 : Moves the `option_data` list into a set, with the option-data defaults filled in
+// where they were never set, and encodes `user_context` as a JSON object.
+func upgradeReservationStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior reservationResourceSchemaV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := reservationResourceSchema{
+		SubnetID:            prior.SubnetID,
+		OperationTarget:     prior.OperationTarget,
+		Hostname:            prior.Hostname,
+		ReservationHostname: prior.ReservationHostname,
+		BootFileName:        prior.BootFileName,
+		ClientClasses:       prior.ClientClasses,
+		ServerHostname:      prior.ServerHostname,
+		ClientID:            hexIdentifierType.valueFrom(prior.ClientID),
+		CircuitID:           hexIdentifierType.valueFrom(prior.CircuitID),
+		DuID:                hexIdentifierType.valueFrom(prior.DuID),
+		FlexID:              hexIdentifierType.valueFrom(prior.FlexID),
+		IPAddress:           ipAddressType.valueFrom(prior.IPAddress),
+		HwAddress:           macAddressType.valueFrom(prior.HwAddress),
+		NextServer:          ipAddressType.valueFrom(prior.NextServer),
+		UserContext:         userContextFromMapV0(ctx, prior.UserContext, &resp.Diagnostics),
+	}
+	resp.Diagnostics.Append(prior.OptionData.ElementsAs(ctx, &state.OptionData, false)...)
+	for i, o := range state.OptionData {
+		if o.Space.IsNull() {
+			state.OptionData[i].Space = types.StringValue(kea.DHCP4OptionSpace)
+		}
+		if o.CSVFormat.IsNull() {
+			state.OptionData[i].CSVFormat = types.BoolValue(true)
+		}
+		if o.NeverSend.IsNull() {
+			state.OptionData[i].NeverSend = types.BoolValue(false)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// userContextFromMapV0 : Encodes a `user_context` map of strings as a JSON object.
+func userContextFromMapV0(ctx context.Context, m types.Map, diags *diag.Diagnostics) jsonObjectValue {
+	if m.IsNull() || m.IsUnknown() {
+		return jsonObjectValue{StringValue: types.StringNull()}
+	}
+	elements := make(map[string]string, len(m.Elements()))
+	diags.Append(m.ElementsAs(ctx, &elements, false)...)
+	b, err := json.Marshal(elements)
+	if err != nil {
+		diags.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode user_context as JSON: %s", err))
+	}
+	return jsonObjectValue{StringValue: types.StringValue(string(b))}
+}
+
+// upgradeUserContextState : Encodes the `user_context` map of strings as a JSON object. Every other attribute
+// is unchanged, so the raw state is rewritten rather than decoded against a frozen prior schema.
+func upgradeUserContextState(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The raw state is missing, unable to upgrade.")
+		return
+	}
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode the raw state: %s", err))
+		return
+	}
+
+	var userContext map[string]string
+	if err := json.Unmarshal(state["user_context"], &userContext); err != nil && len(state["user_context"]) > 0 {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to decode user_context: %s", err))
+		return
+	}
+	state["user_context"] = json.RawMessage("null")
+	if userContext != nil {
+		b, err := json.Marshal(userContext)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode user_context: %s", err))
+			return
+		}
+		if state["user_context"], err = json.Marshal(string(b)); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode user_context: %s", err))
+			return
+		}
+	}
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Unable to encode the raw state: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: b}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeRemoteSubnet4StateV0(t *testing.T) {
+	ctx := context.Background()
+
+	type pool struct {
+		Pool types.String `tfsdk:"pool"`
+	}
+	type relay struct {
+		IPAddress types.String `tfsdk:"ip_address"`
+	}
+	prior := tfsdk.State{Schema: *remoteSubnet4SchemaV0()}
+	prior.Raw = tftypes.NewValue(prior.Schema.Type().TerraformType(ctx), nil)
+	diags := prior.Set(ctx, &struct {
+		Hostname       types.String                         `tfsdk:"hostname"`
+		ID             types.Int64                          `tfsdk:"id"`
+		SubnetID       types.Int64                          `tfsdk:"subnet_id"`
+		SubnetIDStrat  types.String                         `tfsdk:"subnet_id_strategy"`
+		OptionData     []remoteSubnet4OptionResourceModelV0 `tfsdk:"option_data"`
+		Pools          []pool                               `tfsdk:"pools"`
+		Relay          []relay                              `tfsdk:"relay"`
+		Subnet         types.String                         `tfsdk:"subnet"`
+		NextServer     types.String                         `tfsdk:"next_server"`
+		ServerHostname types.String                         `tfsdk:"server_hostname"`
+		BootFileName   types.String                         `tfsdk:"boot_file_name"`
+		UserContext    types.Map                            `tfsdk:"user_context"`
+	}{
+		Hostname: types.StringValue("kea.example.com"),
+		ID:       types.Int64Value(1921682300),
+		SubnetID: types.Int64Value(1921682300),
+		OptionData: []remoteSubnet4OptionResourceModelV0{
+			{Code: types.Int64Value(3), Name: types.StringValue("routers"), Data: types.StringValue("192.168.230.1"), AlwaysSend: types.BoolValue(false)},
+		},
+		Pools:       []pool{{Pool: types.StringValue("192.168.230.10-192.168.230.20")}, {Pool: types.StringValue("192.168.230.64/26")}},
+		Relay:       []relay{{IPAddress: types.StringValue("192.168.230.1")}},
+		Subnet:      types.StringValue("192.168.230.0/24"),
+		UserContext: types.MapNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("State.Set() diagnostics: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	NewRemoteSubnet4Resource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	upgradeRemoteSubnet4StateV0(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgradeRemoteSubnet4StateV0() diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got remoteSubnet4ResourceSchema
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("State.Get() diagnostics: %v", diags)
+	}
+	if len(got.Pools) != 2 || len(got.Relay) != 1 || len(got.OptionData) != 1 {
+		t.Errorf("got %d pools, %d relays and %d options, want 2, 1 and 1", len(got.Pools), len(got.Relay), len(got.OptionData))
+	}
+	if got.Subnet.ValueString() != "192.168.230.0/24" || got.SubnetID.ValueInt64() != 1921682300 {
+		t.Errorf("subnet = %s (%d), want 192.168.230.0/24 (1921682300)", got.Subnet.ValueString(), got.SubnetID.ValueInt64())
+	}
+}
+
+func TestUpgradeReservationStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	prior := tfsdk.State{Schema: *reservationSchemaV0()}
+	prior.Raw = tftypes.NewValue(prior.Schema.Type().TerraformType(ctx), nil)
+	diags := prior.Set(ctx, &reservationResourceSchemaV0{
+		SubnetID:      types.Int64Value(1921682300),
+		Hostname:      types.StringValue("kea.example.com"),
+		IPAddress:     types.StringValue("192.168.230.10"),
+		HwAddress:     types.StringValue("94:8e:d3:db:d8:c5"),
+		ClientClasses: types.ListNull(types.StringType),
+		OptionData:    types.ListNull(reservationSchemaV0().Attributes["option_data"].GetType().(types.ListType).ElemType),
+		UserContext:   types.MapValueMust(types.StringType, map[string]attr.Value{"site": types.StringValue("AUS")}),
+	})
+	diags.Append(prior.SetAttribute(ctx, path.Root("option_data"), []optionDataResourceModel{
+		{
+			Code: types.Int64Value(3), Name: types.StringValue("routers"), Data: types.StringValue("192.168.230.1"),
+			AlwaysSend: types.BoolValue(false), Space: types.StringNull(), CSVFormat: types.BoolNull(), NeverSend: types.BoolNull(),
+		},
+	})...)
+	if diags.HasError() {
+		t.Fatalf("State.Set() diagnostics: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	NewReservationResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	upgradeReservationStateV0(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgradeReservationStateV0() diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got reservationResourceSchema
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("State.Get() diagnostics: %v", diags)
+	}
+	if len(got.OptionData) != 1 || got.OptionData[0].Code.ValueInt64() != 3 {
+		t.Fatalf("option_data = %v, want the routers option", got.OptionData)
+	}
+	if opt := got.OptionData[0]; opt.Space.ValueString() != "dhcp4" || !opt.CSVFormat.ValueBool() || opt.NeverSend.IsNull() || opt.NeverSend.ValueBool() {
+		t.Errorf("option_data defaults = %s, %s, %s, want dhcp4, true, false", opt.Space, opt.CSVFormat, opt.NeverSend)
+	}
+	if got.HwAddress.ValueString() != "94:8e:d3:db:d8:c5" {
+		t.Errorf("hw_address = %s, want 94:8e:d3:db:d8:c5", got.HwAddress.ValueString())
+	}
+	if got.UserContext.ValueString() != `{"site":"AUS"}` {
+		t.Errorf("user_context = %s, want {\"site\":\"AUS\"}", got.UserContext.ValueString())
+	}
+}
+
+func TestUpgradeUserContextState(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior string
+		want  string
+	}{
+		{"map", `{"hostname":"kea.example.com","user_context":{"site":"AUS"}}`, `{"hostname":"kea.example.com","user_context":"{\"site\":\"AUS\"}"}`},
+		{"null", `{"hostname":"kea.example.com","user_context":null}`, `{"hostname":"kea.example.com","user_context":null}`},
+		{"missing", `{"hostname":"kea.example.com"}`, `{"hostname":"kea.example.com","user_context":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp resource.UpgradeStateResponse
+			upgradeUserContextState(context.Background(), resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.prior)}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("upgradeUserContextState() diagnostics: %v", resp.Diagnostics)
+			}
+			if got := string(resp.DynamicValue.JSON); got != tt.want {
+				t.Errorf("upgradeUserContextState() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+// vendorSubOptionTypes : Option types of option 43 sub-options, records and empty options need more than a single value.
+var vendorSubOptionTypes = slices.DeleteFunc(slices.Clone(kea.OptionDataTypes), func(t string) bool {
+	return t == "record" || t == "empty"
+})
+
+type (
+	// classlessStaticRouteResourceModel : Represents a single classless static route of option 121.
+	classlessStaticRouteResourceModel struct {
+		Destination networkStringValue `tfsdk:"destination"`
+		Gateway     networkStringValue `tfsdk:"gateway"`
+	}
+
+	// vendorOptionResourceModel : Represents a single sub-option of option 43.
+	vendorOptionResourceModel struct {
+		Code types.Int64  `tfsdk:"code"`
+		Name types.String `tfsdk:"name"`
+		Type types.String `tfsdk:"type"`
+		Data types.String `tfsdk:"data"`
+	}
+)
+
+// classlessStaticRoutesAttribute : Returns the schema of the option 121 routes.
+func classlessStaticRoutesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Classless static routes sent in option 121, which the provider encodes into the option-data. " +
+			"e.g. `[{destination = \"10.0.0.0/8\", gateway = \"192.168.230.1\"}]`. Option 121 can't also be set in `option_data`.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"destination": schema.StringAttribute{
+					MarkdownDescription: "Destination prefix of the route. e.g. `10.0.0.0/8`",
+					Required:            true,
+					CustomType:          prefixType,
+					Validators:          []validator.String{ipv4PrefixValidator()},
+				},
+				"gateway": schema.StringAttribute{
+					MarkdownDescription: "Router to reach the destination through. e.g. `192.168.230.1`",
+					Required:            true,
+					CustomType:          ipAddressType,
+					Validators:          []validator.String{ipv4AddressValidator()},
+				},
+			},
+		},
+	}
+}
+
+// vendorOptionsAttribute : Returns the schema of the option 43 sub-options.
+func vendorOptionsAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Vendor sub-options sent in option 43. The provider writes an option-def for each sub-option " +
+			"in the `" + kea.VendorEncapsulatedOptionsSpace + "` space, and deletes it again when the sub-option is removed or " +
+			"the subnet is destroyed, unless another subnet still carries the sub-option. A def that already exists with the same name and type is shared and left in place, " +
+			"one with a different name or type is reported as a conflict. e.g. `[{code = 1, name = \"controller\", type = \"ipv4-address\", data = \"192.168.230.5\"}]`. " +
+			"Option 43 and its sub-options can't also be set in `option_data`.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"code": schema.Int64Attribute{
+					MarkdownDescription: "Code of the sub-option, between 1 and 254.",
+					Required:            true,
+					Validators:          []validator.Int64{optionCodeValidator(kea.DHCP4OptionSpace)},
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the sub-option's option-def. e.g. `controller`",
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Option type of the sub-option's option-def. e.g. `ipv4-address`, `string`, `binary`",
+					Required:            true,
+					Validators:          []validator.String{oneOfValidator(vendorSubOptionTypes...)},
+				},
+				"data": schema.StringAttribute{
+					MarkdownDescription: "Value of the sub-option, validated against `type`.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// structuredOptionsFromSchema : Renders the option 121 routes and option 43 sub-options into Kea option-data,
+// along with the option-defs the sub-options need.
+func structuredOptionsFromSchema(routes []classlessStaticRouteResourceModel, vendor []vendorOptionResourceModel) ([]kea.OptionData, []kea.RemoteOptionDef4, error) {
+	opts := make([]kea.OptionData, 0)
+	if len(routes) > 0 {
+		r := make([]kea.Route, 0, len(routes))
+		for _, v := range routes {
+			r = append(r, kea.Route{Destination: v.Destination.ValueString(), Gateway: v.Gateway.ValueString()})
+		}
+		o, err := kea.ClasslessStaticRouteOption(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, o)
+	}
+
+	if len(vendor) == 0 {
+		return opts, nil, nil
+	}
+	subs := make([]kea.VendorSubOption, 0, len(vendor))
+	for _, v := range vendor {
+		subs = append(subs, kea.VendorSubOption{
+			Code: int(v.Code.ValueInt64()),
+			Name: v.Name.ValueString(),
+			Type: v.Type.ValueString(),
+			Data: v.Data.ValueString(),
+		})
+	}
+	vendorOpts, defs := kea.VendorEncapsulatedOptions(subs)
+	return append(opts, vendorOpts...), defs, nil
+}
+
+// structuredOptionsToSchema : Moves the options owned by non-null `classless_static_routes` and `vendor_options`
+// out of the Kea option-data and into the models, returning the remaining option-data. Sub-options keep the
+// type of their prior entry, and are read as `binary` otherwise.
+func structuredOptionsToSchema(routes *[]classlessStaticRouteResourceModel, vendor *[]vendorOptionResourceModel, opts []kea.OptionData, diags *diag.Diagnostics) []kea.OptionData {
+	priorTypes := make(map[int64]types.String)
+	for _, v := range *vendor {
+		priorTypes[v.Code.ValueInt64()] = v.Type
+	}
+	ownRoutes, ownVendor := *routes != nil, *vendor != nil
+	if ownRoutes {
+		*routes = make([]classlessStaticRouteResourceModel, 0)
+	}
+	if ownVendor {
+		*vendor = make([]vendorOptionResourceModel, 0)
+	}
+
+	ret := make([]kea.OptionData, 0, len(opts))
+	for _, o := range opts {
+		space, code := kea.DHCP4OptionSpace, 0
+		if o.Space != nil && *o.Space != "" {
+			space = *o.Space
+		}
+		if o.Code != nil {
+			code = *o.Code
+		}
+
+		switch {
+		case ownRoutes && space == kea.DHCP4OptionSpace && code == kea.ClasslessStaticRouteCode:
+			parsed, err := kea.ParseClasslessStaticRoutes(o)
+			if err != nil {
+				diags.AddError("Invalid Classless Static Routes", fmt.Sprintf("Unable to read option 121 from Kea: %s", err))
+				continue
+			}
+			for _, r := range parsed {
+				*routes = append(*routes, classlessStaticRouteResourceModel{
+					Destination: prefixType.value(r.Destination),
+					Gateway:     ipAddressType.value(r.Gateway),
+				})
+			}
+		case ownVendor && space == kea.DHCP4OptionSpace && code == kea.VendorEncapsulatedOptionsCode:
+			// The sub-options carry the data of option 43.
+		case ownVendor && space == kea.VendorEncapsulatedOptionsSpace:
+			typ, ok := priorTypes[int64(code)]
+			if !ok {
+				typ = types.StringValue("binary")
+			}
+			*vendor = append(*vendor, vendorOptionResourceModel{
+				Code: types.Int64Value(int64(code)),
+				Name: types.StringValue(o.Name),
+				Type: typ,
+				Data: types.StringValue(o.Data),
+			})
+		default:
+			ret = append(ret, o)
+		}
+	}
+	return ret
+}
+
+// validateStructuredOptions : Validates that options owned by `classless_static_routes` and `vendor_options` are
+// not also set in `option_data`, and that sub-option data suits the sub-option type.
+func validateStructuredOptions(opts []optionDataResourceModel, routes []classlessStaticRouteResourceModel, vendor []vendorOptionResourceModel, diags *diag.Diagnostics) {
+	for _, o := range opts {
+		space := kea.DHCP4OptionSpace
+		if !o.Space.IsNull() && !o.Space.IsUnknown() {
+			space = o.Space.ValueString()
+		}
+		def := standardOptionDef4(space, int(o.Code.ValueInt64()), o.Name.ValueString())
+		switch {
+		case routes != nil && def != nil && def.Code == kea.ClasslessStaticRouteCode:
+			diags.AddAttributeError(
+				path.Root("option_data"),
+				"Conflicting Option Data",
+				"Option 121 is configured by `classless_static_routes`, remove it from `option_data`.",
+			)
+		case vendor != nil && (space == kea.VendorEncapsulatedOptionsSpace || (def != nil && def.Code == kea.VendorEncapsulatedOptionsCode)):
+			diags.AddAttributeError(
+				path.Root("option_data"),
+				"Conflicting Option Data",
+				"Option 43 and its sub-options are configured by `vendor_options`, remove them from `option_data`.",
+			)
+		}
+	}
+
+	codes := make(map[int64]bool, len(vendor))
+	for _, v := range vendor {
+		if v.Code.IsUnknown() || v.Type.IsUnknown() || v.Data.IsUnknown() {
+			continue
+		}
+		if codes[v.Code.ValueInt64()] {
+			diags.AddAttributeError(
+				path.Root("vendor_options"),
+				"Duplicate Vendor Option",
+				fmt.Sprintf("Sub-option code %d is configured more than once.", v.Code.ValueInt64()),
+			)
+		}
+		codes[v.Code.ValueInt64()] = true
+
+		def := kea.RemoteOptionDef4{Code: int(v.Code.ValueInt64()), Name: v.Name.ValueString(), Type: v.Type.ValueString()}
+		if err := def.ValidateData(v.Data.ValueString(), true); err != nil {
+			diags.AddAttributeError(path.Root("vendor_options"), "Invalid Vendor Option Data", err.Error())
+		}
+	}
+}
+
+// vendorOptionDefsKey : Private state key of the codes of the vendor sub-option defs the subnet created.
+const vendorOptionDefsKey = "vendor_option_defs"
+
+type (
+	// privateStateGetter : Reads the private state of a resource, e.g. the Private field of a request.
+	privateStateGetter interface {
+		GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	}
+
+	// privateStateSetter : Writes the private state of a resource, e.g. the Private field of a response.
+	privateStateSetter interface {
+		SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+	}
+)
+
+// vendorOptionDefSet : Reports whether the def of a vendor sub-option must be written, given the def that
+// exists in Kea, if any, and whether the subnet created it. Defs created by the subnet are rewritten, an
+// identical def defined elsewhere is shared, and one with another name or type is a conflict.
+func vendorOptionDefSet(def kea.RemoteOptionDef4, existing *kea.RemoteOptionDef4, owned bool) (bool, error) {
+	switch {
+	case existing == nil || owned:
+		return true, nil
+	case existing.Name != def.Name || existing.Type != def.Type || existing.Array != def.Array:
+		return false, fmt.Errorf(
+			"vendor sub-option %d `%s` of type `%s` conflicts with the option-def `%s` of type `%s` already defined "+
+				"in the `%s` space", def.Code, def.Name, def.Type, existing.Name, existing.Type, def.Space,
+		)
+	}
+	return false, nil
+}
+
+// staleVendorOptionDefs : Returns the codes of the defs the subnet owned that it no longer uses.
+func staleVendorOptionDefs(owned, kept []int) []int {
+	return slices.DeleteFunc(slices.Clone(owned), func(code int) bool {
+		return slices.Contains(kept, code)
+	})
+}
+
+// ownedVendorOptionDefs : Returns the codes of the vendor sub-option defs the subnet created, from the private state.
+func ownedVendorOptionDefs(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) []int {
+	b, d := private.GetKey(ctx, vendorOptionDefsKey)
+	diags.Append(d...)
+	if len(b) == 0 {
+		return nil
+	}
+	var codes []int
+	if err := json.Unmarshal(b, &codes); err != nil {
+		diags.AddWarning("Invalid Private State", fmt.Sprintf("Unable to decode the owned vendor sub-option defs: %s", err))
+		return nil
+	}
+	return codes
+}
+
+// setOwnedVendorOptionDefs : Records the codes of the vendor sub-option defs the subnet created in the private state.
+func setOwnedVendorOptionDefs(ctx context.Context, private privateStateSetter, codes []int, diags *diag.Diagnostics) {
+	b, err := json.Marshal(codes)
+	if err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to encode the owned vendor sub-option defs: %s", err))
+		return
+	}
+	diags.Append(private.SetKey(ctx, vendorOptionDefsKey, b)...)
+}
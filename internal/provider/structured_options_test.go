@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestStructuredOptionsRoundTrip(t *testing.T) {
+	routes := []classlessStaticRouteResourceModel{
+		{Destination: prefixType.value("10.0.0.0/8"), Gateway: ipAddressType.value("192.168.230.1")},
+		{Destination: prefixType.value("172.16.128.0/17"), Gateway: ipAddressType.value("192.168.230.2")},
+		{Destination: prefixType.value("0.0.0.0/0"), Gateway: ipAddressType.value("192.168.230.254")},
+	}
+	vendor := []vendorOptionResourceModel{
+		{Code: types.Int64Value(1), Name: types.StringValue("controller"), Type: types.StringValue("ipv4-address"), Data: types.StringValue("192.168.230.5")},
+	}
+
+	opts, defs, err := structuredOptionsFromSchema(routes, vendor)
+	if err != nil {
+		t.Fatalf("structuredOptionsFromSchema() error: %s", err)
+	}
+	if len(opts) != 3 || opts[0].Data != "080ac0a8e60111ac1080c0a8e60200c0a8e6fe" {
+		t.Errorf("structuredOptionsFromSchema() option-data = %+v", opts)
+	}
+	if len(defs) != 1 || defs[0].Space != kea.VendorEncapsulatedOptionsSpace || defs[0].Type != "ipv4-address" {
+		t.Errorf("structuredOptionsFromSchema() option-defs = %+v", defs)
+	}
+
+	// Kea also reports unrelated options, which stay in option_data.
+	code := 3
+	opts = append(opts, kea.OptionData{Code: &code, Name: "routers", Data: "192.168.230.1"})
+
+	gotRoutes := make([]classlessStaticRouteResourceModel, 0)
+	gotVendor := []vendorOptionResourceModel{{Code: types.Int64Value(1), Type: types.StringValue("ipv4-address")}}
+	var diags diag.Diagnostics
+	rest := structuredOptionsToSchema(&gotRoutes, &gotVendor, opts, &diags)
+	if diags.HasError() {
+		t.Fatalf("structuredOptionsToSchema() diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(gotRoutes, routes) {
+		t.Errorf("routes did not round-trip:\n got %+v\nwant %+v", gotRoutes, routes)
+	}
+	if !reflect.DeepEqual(gotVendor, vendor) {
+		t.Errorf("vendor options did not round-trip:\n got %+v\nwant %+v", gotVendor, vendor)
+	}
+	if len(rest) != 1 || rest[0].Name != "routers" {
+		t.Errorf("structuredOptionsToSchema() remaining option-data = %+v, want routers", rest)
+	}
+}
+
+func TestStructuredOptionsToSchemaUnowned(t *testing.T) {
+	o, err := kea.ClasslessStaticRouteOption([]kea.Route{{Destination: "10.0.0.0/8", Gateway: "192.168.230.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var routes []classlessStaticRouteResourceModel
+	var vendor []vendorOptionResourceModel
+	var diags diag.Diagnostics
+	rest := structuredOptionsToSchema(&routes, &vendor, []kea.OptionData{o}, &diags)
+	if routes != nil || vendor != nil || len(rest) != 1 {
+		t.Errorf("structuredOptionsToSchema() took options while the attributes are null: %+v, %+v, %+v", routes, vendor, rest)
+	}
+}
+
+func TestParseClasslessStaticRoutesKeaFormat(t *testing.T) {
+	got, err := kea.ParseClasslessStaticRoutes(kea.OptionData{Data: "10.229.0.128/25 - 10.229.0.1, 10.198.122.47/32 - 10.198.122.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []kea.Route{{Destination: "10.229.0.128/25", Gateway: "10.229.0.1"}, {Destination: "10.198.122.47/32", Gateway: "10.198.122.1"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseClasslessStaticRoutes() = %+v, want %+v", got, want)
+	}
+}
+
+func TestValidateStructuredOptions(t *testing.T) {
+	routes := []classlessStaticRouteResourceModel{}
+	vendor := []vendorOptionResourceModel{}
+	opt := func(code int64, space string) optionDataResourceModel {
+		return optionDataResourceModel{Code: types.Int64Value(code), Name: types.StringNull(), Space: types.StringValue(space)}
+	}
+	sub := func(code int64, typ, data string) vendorOptionResourceModel {
+		return vendorOptionResourceModel{Code: types.Int64Value(code), Name: types.StringValue("sub"), Type: types.StringValue(typ), Data: types.StringValue(data)}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		opts    []optionDataResourceModel
+		routes  []classlessStaticRouteResourceModel
+		vendor  []vendorOptionResourceModel
+		wantErr bool
+	}{
+		{name: "unowned option 121", opts: []optionDataResourceModel{opt(121, kea.DHCP4OptionSpace)}},
+		{name: "option 121 twice", opts: []optionDataResourceModel{opt(121, kea.DHCP4OptionSpace)}, routes: routes, wantErr: true},
+		{name: "option 43 twice", opts: []optionDataResourceModel{opt(43, kea.DHCP4OptionSpace)}, vendor: vendor, wantErr: true},
+		{name: "sub-option twice", opts: []optionDataResourceModel{opt(1, kea.VendorEncapsulatedOptionsSpace)}, vendor: vendor, wantErr: true},
+		{name: "valid sub-options", vendor: []vendorOptionResourceModel{sub(1, "ipv4-address", "192.168.230.5"), sub(2, "string", "ap")}},
+		{name: "invalid sub-option data", vendor: []vendorOptionResourceModel{sub(1, "ipv4-address", "ap")}, wantErr: true},
+		{name: "duplicate sub-option", vendor: []vendorOptionResourceModel{sub(1, "string", "a"), sub(1, "string", "b")}, wantErr: true},
+	} {
+		var diags diag.Diagnostics
+		validateStructuredOptions(tc.opts, tc.routes, tc.vendor, &diags)
+		if diags.HasError() != tc.wantErr {
+			t.Errorf("%s: validateStructuredOptions() errors = %v, want error %v", tc.name, diags, tc.wantErr)
+		}
+	}
+}
+
+func TestVendorOptionDefSet(t *testing.T) {
+	def := kea.RemoteOptionDef4{Code: 1, Name: "controller", Type: "ipv4-address", Space: kea.VendorEncapsulatedOptionsSpace}
+	other := kea.RemoteOptionDef4{Code: 1, Name: "ap-name", Type: "string", Space: kea.VendorEncapsulatedOptionsSpace}
+	for _, tc := range []struct {
+		name     string
+		existing *kea.RemoteOptionDef4
+		owned    bool
+		wantSet  bool
+		wantErr  bool
+	}{
+		{name: "missing", wantSet: true},
+		{name: "owned", existing: &def, owned: true, wantSet: true},
+		{name: "owned with another type", existing: &other, owned: true, wantSet: true},
+		{name: "shared", existing: &def},
+		{name: "conflict", existing: &other, wantErr: true},
+	} {
+		set, err := vendorOptionDefSet(def, tc.existing, tc.owned)
+		if set != tc.wantSet || (err != nil) != tc.wantErr {
+			t.Errorf("%s: vendorOptionDefSet() = %v, %v, want %v, error %v", tc.name, set, err, tc.wantSet, tc.wantErr)
+		}
+	}
+
+	if got := staleVendorOptionDefs([]int{1, 2, 3}, []int{2}); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("staleVendorOptionDefs() = %v, want [1 3]", got)
+	}
+}
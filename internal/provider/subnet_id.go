@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+const (
+	// subnetIDStrategyExplicit : Use the `subnet_id` given in the configuration.
+	subnetIDStrategyExplicit = "explicit"
+	// subnetIDStrategyDerived : Derive the ID from the network address, e.g. `192.168.230.0/24` => `1921682300`.
+	subnetIDStrategyDerived = "derived"
+	// subnetIDStrategyNextFree : Use the lowest ID not used by any subnet in the configuration-backend.
+	subnetIDStrategyNextFree = "next_free"
+)
+
+// subnetIDStrategies : Every supported subnet ID allocation strategy.
+var subnetIDStrategies = []string{subnetIDStrategyExplicit, subnetIDStrategyDerived, subnetIDStrategyNextFree}
+
+// deriveSubnetID : Derives a subnet ID by stripping the dots from the network address
+// of the prefix. This is the historical allocation of the provider and is not collision
+// free, so the result must always be checked with checkSubnetID.
+func deriveSubnetID(prefix string) (int, error) {
+	id, err := strconv.ParseUint(cidrToIDRepl.Replace(strings.Split(prefix, "/")[0]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to derive a subnet ID from `%s`: %w", prefix, err)
+	}
+	if id == 0 || id > kea.MaxSubnetID {
+		return 0, fmt.Errorf(
+			"subnet ID %d derived from `%s` is outside of Kea's range 1-%d, set `subnet_id` or use the `%s` strategy",
+			id, prefix, kea.MaxSubnetID, subnetIDStrategyNextFree,
+		)
+	}
+	return int(id), nil
+}
+
+// checkSubnetID : Refuses a subnet ID that already belongs to a different prefix in the
+// configuration-backend, which would otherwise be silently overwritten by remote-subnet4-set.
+// The ID may belong to one of the owned prefixes, e.g. the prior prefix of a subnet being updated.
+func checkSubnetID(client *kea.Client, hostname, prefix string, id int, owned ...string) error {
+	existing, err := client.RemoteSubnet4GetByID(hostname, id)
+	if err != nil {
+		if errors.Is(err, kea.ErrNotFound) || strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+	if slices.ContainsFunc(owned, func(p string) bool { return kea.SamePrefix(existing.Subnet, p) }) {
+		return nil
+	}
+	if !kea.SamePrefix(existing.Subnet, prefix) {
+		return fmt.Errorf("subnet ID %d already belongs to `%s`, refusing to overwrite it with `%s`", id, existing.Subnet, prefix)
+	}
+	return nil
+}
+
+// allocateSubnetID : Returns the subnet ID for a new subnet, using the given strategy.
+func allocateSubnetID(client *kea.Client, hostname, prefix, strategy string, explicit *int) (int, error) {
+	var id int
+	var err error
+
+	switch strategy {
+	case subnetIDStrategyExplicit:
+		if explicit == nil {
+			return 0, fmt.Errorf("`subnet_id` is required with the `%s` strategy", subnetIDStrategyExplicit)
+		}
+		id = *explicit
+		if id < 1 || id > kea.MaxSubnetID {
+			return 0, fmt.Errorf("subnet ID %d is outside of Kea's range 1-%d", id, kea.MaxSubnetID)
+		}
+	case subnetIDStrategyDerived:
+		if id, err = deriveSubnetID(prefix); err != nil {
+			return 0, err
+		}
+	case subnetIDStrategyNextFree:
+		// The next free ID is collision free by construction.
+		return client.RemoteSubnet4NextFreeID(hostname, prefix)
+	default:
+		return 0, fmt.Errorf("unknown subnet ID strategy `%s`, must be one of %s", strategy, strings.Join(subnetIDStrategies, ", "))
+	}
+
+	if err := checkSubnetID(client, hostname, prefix, id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+func TestDeriveSubnetID(t *testing.T) {
+	tests := []struct {
+		prefix  string
+		want    int
+		wantErr bool
+	}{
+		{prefix: "192.168.230.0/24", want: 1921682300},
+		{prefix: "10.0.0.0/8", want: 10000},
+		{prefix: "255.255.255.0/24", want: 2552552550},
+		{prefix: "100.100.100.100/32", wantErr: true},
+		{prefix: "0.0.0.0/0", wantErr: true},
+		{prefix: "not-a-prefix", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := deriveSubnetID(tt.prefix)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("deriveSubnetID(%q) error = %v, wantErr %v", tt.prefix, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("deriveSubnetID(%q) = %d, want %d", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestSamePrefix(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "192.168.230.0/24", b: "192.168.230.0/24", want: true},
+		{a: "192.168.230.0/24", b: "192.168.230.7/24", want: true},
+		{a: "192.168.230.0/24", b: "192.168.230.0/25", want: false},
+		{a: "192.168.23.0/24", b: "192.168.2.30/24", want: false},
+	}
+	for _, tt := range tests {
+		if got := kea.SamePrefix(tt.a, tt.b); got != tt.want {
+			t.Errorf("kea.SamePrefix(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/josh-silvas/terraform-provider-kea/tools/kea"
+)
+
+const (
+	// optionCodeMin, optionCodeMax : Option codes that can be configured in the dhcp4 space,
+	// 0 (pad) and 255 (end) are reserved.
+	optionCodeMin = 1
+	optionCodeMax = 254
+	// option6CodeMax : Highest option code in the dhcp6 space.
+	option6CodeMax = 65535
+)
+
+type (
+	// stringValidator : Validates a known string value with fn, and reports its error as an attribute diagnostic.
+	stringValidator struct {
+		summary     string
+		description string
+		fn          func(string) error
+	}
+
+	// int64RangeValidator : Validates that a known int64 value is between min and max, inclusive.
+	int64RangeValidator struct {
+		summary  string
+		min, max int64
+	}
+
+	// float64FractionValidator : Validates that a known float64 value is a fraction between 0 and 1, inclusive.
+	float64FractionValidator struct{}
+
+	// optionKey : Identifies an option-data entry, Kea holds at most one option per code and space.
+	// Entries configured by name only are identified by their name.
+	optionKey struct {
+		code  int64
+		name  string
+		space string
+	}
+)
+
+// Description : Describes the validation in plain text.
+func (v stringValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+// MarkdownDescription : Describes the validation in Markdown.
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString : Runs the validation, unknown and null values are left to apply.
+func (v stringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.fn(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, err.Error())
+	}
+}
+
+// Description : Describes the validation in plain text.
+func (v int64RangeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+// MarkdownDescription : Describes the validation in Markdown.
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateInt64 : Runs the validation, unknown and null values are left to apply.
+func (v int64RangeValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if n := req.ConfigValue.ValueInt64(); n < v.min || n > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, fmt.Sprintf("Value must be between %d and %d, got %d.", v.min, v.max, n))
+	}
+}
+
+// Description : Describes the validation in plain text.
+func (v float64FractionValidator) Description(_ context.Context) string {
+	return "value must be between 0 and 1"
+}
+
+// MarkdownDescription : Describes the validation in Markdown.
+func (v float64FractionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 : Runs the validation, unknown and null values are left to apply.
+func (v float64FractionValidator) ValidateFloat64(_ context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if f := req.ConfigValue.ValueFloat64(); f < 0 || f > 1 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Fraction", fmt.Sprintf("Value must be between 0 and 1, got %g.", f))
+	}
+}
+
+// fractionValidator : Validates a fraction attribute, e.g. a percentage of the lease lifetime.
+func fractionValidator() validator.Float64 {
+	return float64FractionValidator{}
+}
+
+// uint32Validator : Validates an attribute that Kea stores as a uint32, e.g. a lifetime in seconds.
+func uint32Validator() validator.Int64 {
+	return int64RangeValidator{summary: "Invalid Value", min: 0, max: math.MaxUint32}
+}
+
+// subnetIDValidator : Validates a subnet ID attribute, 0 and 4294967295 are reserved by Kea.
+func subnetIDValidator() validator.Int64 {
+	return int64RangeValidator{summary: "Invalid Subnet ID", min: 1, max: kea.MaxSubnetID}
+}
+
+// portValidator : Validates a TCP port attribute.
+func portValidator() validator.Int64 {
+	return int64RangeValidator{summary: "Invalid Port", min: 1, max: math.MaxUint16}
+}
+
+// ipv4AddressValidator : Validates an IPv4 address attribute.
+func ipv4AddressValidator() validator.String {
+	return stringValidator{summary: "Invalid IPv4 Address", description: "value must be an IPv4 address", fn: validateIPv4Address}
+}
+
+// ipv4PrefixValidator : Validates an IPv4 prefix attribute.
+func ipv4PrefixValidator() validator.String {
+	return stringValidator{summary: "Invalid IPv4 Prefix", description: "value must be an IPv4 prefix without host bits", fn: validateIPv4Prefix}
+}
+
+// poolValidator : Validates an IPv4 pool attribute.
+func poolValidator() validator.String {
+	return stringValidator{summary: "Invalid Pool", description: "value must be an IPv4 address range or prefix", fn: func(v string) error {
+		_, _, err := parsePool(v)
+		return err
+	}}
+}
+
+// ipv6AddressValidator : Validates an IPv6 address attribute.
+func ipv6AddressValidator() validator.String {
+	return stringValidator{summary: "Invalid IPv6 Address", description: "value must be an IPv6 address", fn: validateIPv6Address}
+}
+
+// pool6Validator : Validates an IPv6 pool attribute.
+func pool6Validator() validator.String {
+	return stringValidator{summary: "Invalid Pool", description: "value must be an IPv6 address range or prefix", fn: func(v string) error {
+		_, _, err := parsePool6(v)
+		return err
+	}}
+}
+
+// macAddressValidator : Validates a hw-address attribute.
+func macAddressValidator() validator.String {
+	return stringValidator{summary: "Invalid MAC Address", description: "value must be a MAC address", fn: validateMACAddress}
+}
+
+// hexIdentifierValidator : Validates a hexadecimal identifier attribute, e.g. a DUID or client-id.
+func hexIdentifierValidator() validator.String {
+	return stringValidator{summary: "Invalid Identifier", description: "value must be hexadecimal octets", fn: validateHexIdentifier}
+}
+
+// oneOfValidator : Validates that a string attribute is one of the values.
+func oneOfValidator(values ...string) validator.String {
+	return stringValidator{
+		summary:     "Invalid Value",
+		description: fmt.Sprintf("value must be one of %s", strings.Join(values, ", ")),
+		fn: func(v string) error {
+			if !slices.Contains(values, v) {
+				return fmt.Errorf("`%s` must be one of %s", v, strings.Join(values, ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// optionCodeValidator : Validates an option code attribute for the option space.
+func optionCodeValidator(space string) validator.Int64 {
+	if space == kea.DHCP6OptionSpace {
+		return int64RangeValidator{summary: "Invalid Option Code", min: optionCodeMin, max: option6CodeMax}
+	}
+	return int64RangeValidator{summary: "Invalid Option Code", min: optionCodeMin, max: optionCodeMax}
+}
+
+// validateIPv4Address : Checks that the value is an IPv4 address, e.g. `192.168.230.10`.
+func validateIPv4Address(v string) error {
+	if ip := net.ParseIP(v); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("`%s` is not a valid IPv4 address, e.g. `192.168.230.10`", v)
+	}
+	return nil
+}
+
+// validateIPv4Prefix : Checks that the value is an IPv4 prefix without host bits, e.g. `192.168.230.0/24`.
+func validateIPv4Prefix(v string) error {
+	ip, network, err := net.ParseCIDR(v)
+	if err != nil || ip.To4() == nil {
+		return fmt.Errorf("`%s` is not a valid IPv4 prefix, e.g. `192.168.230.0/24`", v)
+	}
+	if !ip.Equal(network.IP) {
+		return fmt.Errorf("`%s` has host bits set, did you mean `%s`?", v, network.String())
+	}
+	return nil
+}
+
+// validateIPv6Address : Checks that the value is an IPv6 address, e.g. `2001:db8::10`.
+func validateIPv6Address(v string) error {
+	if ip := net.ParseIP(v); ip == nil || ip.To4() != nil {
+		return fmt.Errorf("`%s` is not a valid IPv6 address, e.g. `2001:db8::10`", v)
+	}
+	return nil
+}
+
+// validateIPv6Prefix : Checks that the value is an IPv6 prefix without host bits, e.g. `2001:db8:1::/48`.
+func validateIPv6Prefix(v string) error {
+	ip, network, err := net.ParseCIDR(v)
+	if err != nil || ip.To4() != nil {
+		return fmt.Errorf("`%s` is not a valid IPv6 prefix, e.g. `2001:db8:1::/48`", v)
+	}
+	if !ip.Equal(network.IP) {
+		return fmt.Errorf("`%s` has host bits set, did you mean `%s`?", v, network.String())
+	}
+	return nil
+}
+
+// validateMACAddress : Checks that the value is a MAC address, e.g. `94:8e:d3:db:d8:c5`.
+func validateMACAddress(v string) error {
+	if _, err := net.ParseMAC(v); err != nil {
+		return fmt.Errorf("`%s` is not a valid MAC address, e.g. `94:8e:d3:db:d8:c5`", v)
+	}
+	return nil
+}
+
+// validateHexIdentifier : Checks that the value is hexadecimal octets, optionally separated by colons,
+// e.g. `00:03:00:01:94:8e:d3:db:d8:c5` or `00030001948ed3dbd8c5`.
+func validateHexIdentifier(v string) error {
+	s := strings.ReplaceAll(v, ":", "")
+	if b, err := hex.DecodeString(s); err != nil || len(b) == 0 || (strings.Contains(v, ":") && len(b) != strings.Count(v, ":")+1) {
+		return fmt.Errorf("`%s` is not a valid hexadecimal identifier, e.g. `00:03:00:01:94:8e:d3:db:d8:c5`", v)
+	}
+	return nil
+}
+
+// validateUniqueOptions : Reports option-data entries that share their code and space. Entries with
+// unknown identity fields should be left out of keys.
+func validateUniqueOptions(attribute path.Path, keys []optionKey, diags *diag.Diagnostics) {
+	seen := make(map[optionKey]bool, len(keys))
+	for _, k := range keys {
+		if seen[k] {
+			diags.AddAttributeError(
+				attribute,
+				"Duplicate Option Data",
+				fmt.Sprintf("Option %s in space `%s` is configured more than once.", k, k.space),
+			)
+		}
+		seen[k] = true
+	}
+}
+
+// String : Describes the option by its code, or its name when the code is not known.
+func (k optionKey) String() string {
+	if k.name != "" {
+		return fmt.Sprintf("`%s`", k.name)
+	}
+	return fmt.Sprintf("code %d", k.code)
+}
+
+// parsePool : Parses a Kea IPv4 pool, either a range `192.168.230.10-192.168.230.200` (spaces around
+// the dash are allowed) or a prefix `192.168.230.64/26`, into its first and last addresses.
+func parsePool(v string) (net.IP, net.IP, error) {
+	if strings.Contains(v, "/") {
+		if err := validateIPv4Prefix(v); err != nil {
+			return nil, nil, err
+		}
+		_, network, _ := net.ParseCIDR(v)
+		start := network.IP.To4()
+		end := make(net.IP, len(start))
+		for i := range start {
+			end[i] = start[i] | ^network.Mask[i]
+		}
+		return start, end, nil
+	}
+
+	bounds := strings.Split(v, "-")
+	if len(bounds) != 2 {
+		return nil, nil, fmt.Errorf("`%s` is not a valid pool, e.g. `192.168.230.10-192.168.230.200` or `192.168.230.64/26`", v)
+	}
+	start, end := net.ParseIP(strings.TrimSpace(bounds[0])).To4(), net.ParseIP(strings.TrimSpace(bounds[1])).To4()
+	if start == nil || end == nil {
+		return nil, nil, fmt.Errorf("`%s` is not a valid pool, e.g. `192.168.230.10-192.168.230.200` or `192.168.230.64/26`", v)
+	}
+	if bytes.Compare(start, end) > 0 {
+		return nil, nil, fmt.Errorf("pool `%s` starts after it ends", v)
+	}
+	return start, end, nil
+}
+
+// parsePool6 : Parses a Kea IPv6 pool, either a range `2001:db8:1::10-2001:db8:1::ff` (spaces around
+// the dash are allowed) or a prefix `2001:db8:1::/112`, into its first and last addresses.
+func parsePool6(v string) (net.IP, net.IP, error) {
+	if strings.Contains(v, "/") {
+		if err := validateIPv6Prefix(v); err != nil {
+			return nil, nil, err
+		}
+		_, network, _ := net.ParseCIDR(v)
+		start := network.IP.To16()
+		end := make(net.IP, len(start))
+		for i := range start {
+			end[i] = start[i] | ^network.Mask[i]
+		}
+		return start, end, nil
+	}
+
+	bounds := strings.Split(v, "-")
+	if len(bounds) != 2 || validateIPv6Address(strings.TrimSpace(bounds[0])) != nil || validateIPv6Address(strings.TrimSpace(bounds[1])) != nil {
+		return nil, nil, fmt.Errorf("`%s` is not a valid pool, e.g. `2001:db8:1::10-2001:db8:1::ff` or `2001:db8:1::/112`", v)
+	}
+	start, end := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+	if bytes.Compare(start, end) > 0 {
+		return nil, nil, fmt.Errorf("pool `%s` starts after it ends", v)
+	}
+	return start, end, nil
+}
+
+// poolInPrefix : Reports whether the pool, as parsed by parsePool, is entirely inside the prefix.
+func poolInPrefix(start, end net.IP, prefix string) bool {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	return network.Contains(start) && network.Contains(end)
+}
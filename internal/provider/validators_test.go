@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePool(t *testing.T) {
+	for _, tc := range []struct {
+		pool       string
+		start, end string
+		wantErr    bool
+	}{
+		{pool: "192.168.230.10-192.168.230.200", start: "192.168.230.10", end: "192.168.230.200"},
+		{pool: "192.168.230.10 - 192.168.230.200", start: "192.168.230.10", end: "192.168.230.200"},
+		{pool: "192.168.230.64/26", start: "192.168.230.64", end: "192.168.230.127"},
+		{pool: "192.168.230.200-192.168.230.10", wantErr: true},
+		{pool: "192.168.230.65/26", wantErr: true},
+		{pool: "192.168.230.10", wantErr: true},
+		{pool: "2001:db8::1-2001:db8::10", wantErr: true},
+	} {
+		start, end, err := parsePool(tc.pool)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parsePool(%q) error = %v, wantErr %v", tc.pool, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (start.String() != tc.start || end.String() != tc.end) {
+			t.Errorf("parsePool(%q) = %s, %s, want %s, %s", tc.pool, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+func TestParsePool6(t *testing.T) {
+	for _, tc := range []struct {
+		pool       string
+		start, end string
+		wantErr    bool
+	}{
+		{pool: "2001:db8:1::10-2001:db8:1::ff", start: "2001:db8:1::10", end: "2001:db8:1::ff"},
+		{pool: "2001:db8:1::10 - 2001:db8:1::ff", start: "2001:db8:1::10", end: "2001:db8:1::ff"},
+		{pool: "2001:db8:1::/120", start: "2001:db8:1::", end: "2001:db8:1::ff"},
+		{pool: "2001:db8:1::ff-2001:db8:1::10", wantErr: true},
+		{pool: "2001:db8:1::1/120", wantErr: true},
+		{pool: "192.168.230.10-192.168.230.200", wantErr: true},
+	} {
+		start, end, err := parsePool6(tc.pool)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("parsePool6(%q) error = %v, wantErr %v", tc.pool, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (start.String() != tc.start || end.String() != tc.end) {
+			t.Errorf("parsePool6(%q) = %s, %s, want %s, %s", tc.pool, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+func TestValidateAddresses(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fn      func(string) error
+		value   string
+		wantErr bool
+	}{
+		{name: "ipv4", fn: validateIPv4Address, value: "192.168.230.1"},
+		{name: "ipv4", fn: validateIPv4Address, value: "2001:db8::1", wantErr: true},
+		{name: "prefix", fn: validateIPv4Prefix, value: "192.168.230.0/24"},
+		{name: "prefix", fn: validateIPv4Prefix, value: "192.168.230.1/24", wantErr: true},
+		{name: "prefix", fn: validateIPv4Prefix, value: "192.168.230.0", wantErr: true},
+		{name: "mac", fn: validateMACAddress, value: "94:8e:d3:db:d8:c5"},
+		{name: "mac", fn: validateMACAddress, value: "94:8e:d3:db:d8", wantErr: true},
+		{name: "hex", fn: validateHexIdentifier, value: "00:03:00:01:94:8e:d3:db:d8:c5"},
+		{name: "hex", fn: validateHexIdentifier, value: "00030001948ed3dbd8c5"},
+		{name: "hex", fn: validateHexIdentifier, value: "0:03:00", wantErr: true},
+		{name: "hex", fn: validateHexIdentifier, value: "zz:03", wantErr: true},
+		{name: "hex", fn: validateHexIdentifier, value: "", wantErr: true},
+	} {
+		if err := tc.fn(tc.value); (err != nil) != tc.wantErr {
+			t.Errorf("%s(%q) error = %v, wantErr %v", tc.name, tc.value, err, tc.wantErr)
+		}
+	}
+}
+
+func TestValidateSubnet4Pools(t *testing.T) {
+	pools := func(v ...string) []remoteSubnet4PoolResourceModel {
+		r := make([]remoteSubnet4PoolResourceModel, 0, len(v))
+		for _, p := range v {
+			r = append(r, remoteSubnet4PoolResourceModel{Pool: poolType.value(p)})
+		}
+		return r
+	}
+
+	for _, tc := range []struct {
+		name   string
+		subnet networkStringValue
+		pools  []remoteSubnet4PoolResourceModel
+		errors int
+	}{
+		{name: "valid", subnet: prefixType.value("192.168.230.0/24"), pools: pools("192.168.230.10-192.168.230.100", "192.168.230.128/26")},
+		{name: "outside", subnet: prefixType.value("192.168.230.0/24"), pools: pools("192.168.231.10-192.168.231.100"), errors: 1},
+		{name: "overlap", subnet: prefixType.value("192.168.230.0/24"), pools: pools("192.168.230.10-192.168.230.100", "192.168.230.64/26"), errors: 1},
+		{name: "unknown subnet", subnet: networkStringValue{StringValue: types.StringUnknown(), kind: networkKindPrefix}, pools: pools("10.0.0.1-10.0.0.2", "10.0.0.2-10.0.0.3"), errors: 1},
+	} {
+		var diags diag.Diagnostics
+		validateSubnet4Pools(remoteSubnet4ResourceSchema{Subnet: tc.subnet, Pools: tc.pools}, &diags)
+		if diags.ErrorsCount() != tc.errors {
+			t.Errorf("%s: got %d errors, want %d: %v", tc.name, diags.ErrorsCount(), tc.errors, diags)
+		}
+	}
+}
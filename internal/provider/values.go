@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValueOrNull : Converts an optional Kea string into a Terraform value, returning
+// null when Kea reports the field as empty.
+func stringValueOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// intPointer : Converts a Terraform value into an optional Kea integer, nil when null or unknown.
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+// stringListValue : Converts a Kea string list into a Terraform list. An empty Kea list keeps
+// a prior null or empty list as is, so that both `[]` and an omitted attribute round-trip.
+func stringListValue(prior types.List, v []string, diags *diag.Diagnostics) types.List {
+	elems := make([]attr.Value, 0, len(v))
+	for _, s := range v {
+		elems = append(elems, types.StringValue(s))
+	}
+	return listValueOrPrior(prior, types.StringType, elems, diags)
+}
+
+// listValueOrPrior : Builds a Terraform list of the element type, keeping a prior null or empty list
+// as is when there are no elements.
+func listValueOrPrior(prior types.List, elemType attr.Type, elems []attr.Value, diags *diag.Diagnostics) types.List {
+	if len(elems) == 0 && (prior.IsNull() || len(prior.Elements()) == 0) {
+		if prior.IsUnknown() {
+			return types.ListNull(elemType)
+		}
+		return prior
+	}
+
+	lv, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return lv
+}
+
+// stringValues : Converts a Kea string list into a list of Terraform values.
+func stringValues(v []string) []types.String {
+	ret := make([]types.String, 0, len(v))
+	for _, s := range v {
+		ret = append(ret, types.StringValue(s))
+	}
+	return ret
+}
+
+// stringListElements : Converts a Terraform string list into a Kea string list.
+func stringListElements(v types.List) []string {
+	var ret []string
+	for _, e := range v.Elements() {
+		if s, ok := e.(interface{ ValueString() string }); ok {
+			ret = append(ret, s.ValueString())
+		}
+	}
+	return ret
+}
@@ -0,0 +1,137 @@
+package kea
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type (
+	// Config : Represents the running configuration of a single Kea service.
+	Config struct {
+		// Hash : Configuration hash reported by Kea, empty on releases that do not report it.
+		Hash string `json:"-"`
+		// Raw : Full service configuration, e.g. the contents of the `Dhcp4` map.
+		Raw json.RawMessage `json:"-"`
+
+		ValidLifetime    *int                  `json:"valid-lifetime"`
+		MinValidLifetime *int                  `json:"min-valid-lifetime"`
+		MaxValidLifetime *int                  `json:"max-valid-lifetime"`
+		RenewTimer       *int                  `json:"renew-timer"`
+		RebindTimer      *int                  `json:"rebind-timer"`
+		Subnet4          []ConfigSubnet        `json:"subnet4"`
+		Subnet6          []ConfigSubnet        `json:"subnet6"`
+		SharedNetworks   []ConfigSharedNetwork `json:"shared-networks"`
+	}
+
+	// ConfigSubnet : Represents a single subnet entry in the running configuration.
+	ConfigSubnet struct {
+		ID     int    `json:"id"`
+		Subnet string `json:"subnet"`
+	}
+
+	// ConfigSharedNetwork : Represents a single shared-network entry in the running configuration.
+	ConfigSharedNetwork struct {
+		Name    string         `json:"name"`
+		Subnet4 []ConfigSubnet `json:"subnet4"`
+		Subnet6 []ConfigSubnet `json:"subnet6"`
+	}
+)
+
+// configServiceKeys : Maps a Kea service name to the top-level key of its configuration.
+var configServiceKeys = map[string]string{
+	"dhcp4": "Dhcp4",
+	"dhcp6": "Dhcp6",
+	"d2":    "DhcpDdns",
+}
+
+// Subnets : Returns every subnet in the running configuration, including the
+// subnets nested under shared-networks.
+func (c Config) Subnets() []ConfigSubnet {
+	ret := make([]ConfigSubnet, 0, len(c.Subnet4)+len(c.Subnet6))
+	ret = append(ret, c.Subnet4...)
+	ret = append(ret, c.Subnet6...)
+	for _, n := range c.SharedNetworks {
+		ret = append(ret, n.Subnet4...)
+		ret = append(ret, n.Subnet6...)
+	}
+	return ret
+}
+
+// ConfigGet : Gets the running configuration of a Kea service, as merged from the
+// configuration file and the configuration-backend.
+//
+// POST / {"command": "config-get","service":["dhcp4"]}'
+func (c *Client) ConfigGet(hostname, service string) (*Config, error) {
+	key, ok := configServiceKeys[service]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidService, service)
+	}
+
+	payload := Request{Command: "config-get", Service: []string{service}}
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret map[string]json.RawMessage
+	if _, err := c.do(req, &ret); err != nil {
+		return nil, err
+	}
+
+	raw, ok := ret[key]
+	if !ok {
+		return nil, fmt.Errorf("config-get response is missing the `%s` configuration", key)
+	}
+
+	cfg := &Config{Raw: raw}
+	if err := json.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("failure decoding `%s` configuration: %w", key, err)
+	}
+	if hash, ok := ret["hash"]; ok {
+		if err := json.Unmarshal(hash, &cfg.Hash); err != nil {
+			return nil, fmt.Errorf("failure decoding configuration hash: %w", err)
+		}
+	}
+	return cfg, nil
+}
+
+// ConfigBackendPull : Forces the server to fetch the configuration updates from the
+// configuration-backend immediately, instead of waiting for `config-fetch-wait-time`.
+//
+// POST / {"command": "config-backend-pull","service":["dhcp4"]}'
+func (c *Client) ConfigBackendPull(hostname, service string) error {
+	payload := Request{Command: "config-backend-pull", Service: []string{service}}
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.do(req, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PullAfterApply : Sends config-backend-pull to the configured servers after a configuration-backend
+// write. It is a no-op unless the client was created with WithPullAfterApply. When no servers were
+// given to WithPullAfterApply, the pull is sent to the hostname that received the write.
+func (c *Client) PullAfterApply(hostname, service string) error {
+	if !c.pullAfterApply {
+		return nil
+	}
+
+	servers := c.pullServers
+	if len(servers) == 0 {
+		servers = []string{hostname}
+	}
+
+	errs := make([]error, 0)
+	for _, server := range servers {
+		if err := c.ConfigBackendPull(server, service); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", server, err))
+		}
+	}
+	return errors.Join(errs...)
+}
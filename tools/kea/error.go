@@ -7,6 +7,12 @@ var (
 	ErrInvalidIP = errors.New("invalid IP address")
 	// ErrInvalidMAC : Invalid MAC address
 	ErrInvalidMAC = errors.New("invalid MAC address")
+	// ErrInvalidIdentifier : Invalid reservation identifier
+	ErrInvalidIdentifier = errors.New("invalid reservation identifier")
 	// ErrInvalidSubnet : Invalid subnet
 	ErrInvalidSubnet = errors.New("invalid subnet ID")
+	// ErrInvalidService : Invalid Kea service
+	ErrInvalidService = errors.New("invalid service")
+	// ErrNotFound : The requested resource was not found (Kea result code 3)
+	ErrNotFound = errors.New("not found")
 )
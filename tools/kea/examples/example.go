@@ -37,7 +37,7 @@ func main() {
 			Relay: kea.Relay{
 				IPAddresses: []string{"192.168.227.1"},
 			},
-			UserContext: map[string]string{
+			UserContext: map[string]any{
 				"site":        "San Antonio",
 				"description": "San Antonio test subnet",
 			},
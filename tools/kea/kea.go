@@ -21,13 +21,26 @@ const (
 	envKEAPASS  = "KEA_PASSWORD"
 )
 
+const (
+	// RemoteTypeMySQL : Remote type of a MySQL configuration-backend.
+	RemoteTypeMySQL = "mysql"
+	// RemoteTypePostgreSQL : Remote type of a PostgreSQL configuration-backend, the default.
+	RemoteTypePostgreSQL = "postgresql"
+)
+
+// RemoteTypes : Values of the remote type.
+var RemoteTypes = []string{RemoteTypeMySQL, RemoteTypePostgreSQL}
+
 type (
 	// Client : Stored memory objects for the CradlePoint client.
 	Client struct {
 		client *http.Client
 		log    *logrus.Logger
 		auth   auth
-		remote string
+		remote Remote
+
+		pullAfterApply bool
+		pullServers    []string
 	}
 	// Response : Similar response returned for all Kea queries.
 	Response struct {
@@ -47,6 +60,14 @@ type (
 		username, password string
 	}
 
+	// Remote : Selects the configuration-backend that remote-* commands apply to. Host and port are only
+	// sent when set, they tell apart several backends of the same type.
+	Remote struct {
+		Type string `json:"type"`
+		Host string `json:"host,omitempty"`
+		Port int    `json:"port,omitempty"`
+	}
+
 	// Metadata : Metadata returned from Kea.
 	Metadata struct {
 		ServerTags []string `json:"server-tags"`
@@ -60,6 +81,25 @@ func New(opts ...Option) *Client {
 	return client
 }
 
+// ForRemote : Returns a copy of the client that sends the given remote selector, the fields set in
+// remote override those of the client. The client itself is returned when remote is empty.
+func (c *Client) ForRemote(remote Remote) *Client {
+	if remote == (Remote{}) {
+		return c
+	}
+	ret := *c
+	if remote.Type != "" {
+		ret.remote.Type = remote.Type
+	}
+	if remote.Host != "" {
+		ret.remote.Host = remote.Host
+	}
+	if remote.Port != 0 {
+		ret.remote.Port = remote.Port
+	}
+	return &ret
+}
+
 // make : creates an API request; a relative URI should be provided for uri
 // and should not have a leading slash for a proper url.Parse() merge
 //
@@ -135,6 +175,11 @@ func checkResponse(resp *http.Response) (*Response, error) {
 		return &e[0], nil
 	}
 
+	// nolint: gosec
+	if e[0].Result == 3 {
+		return nil, fmt.Errorf("%w: result:%d(%s)", ErrNotFound, e[0].Result, e[0].Text)
+	}
+
 	// nolint: gosec
 	return nil, fmt.Errorf("result:%d(%s)", e[0].Result, e[0].Text)
 }
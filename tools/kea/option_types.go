@@ -0,0 +1,182 @@
+package kea
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// OptionDataTypes : Types of option definitions, and of the fields of `record` options.
+var OptionDataTypes = []string{
+	"empty", "binary", "boolean", "int8", "int16", "int32", "uint8", "uint16", "uint32",
+	"ipv4-address", "ipv6-address", "ipv6-prefix", "psid", "record", "string", "tuple", "fqdn",
+}
+
+// ValidateRecordTypes : Validates that record-types is set for, and only for, `record` option definitions,
+// and that every field is a type Kea allows in a record.
+func (d RemoteOptionDef4) ValidateRecordTypes() error {
+	fields := strings.TrimSpace(d.RecordTypes)
+	if d.Type != "record" {
+		if fields != "" {
+			return fmt.Errorf("record-types is only allowed with the `record` type, got type `%s`", d.Type)
+		}
+		return nil
+	}
+	if fields == "" {
+		return fmt.Errorf("record-types is required with the `record` type, e.g. `uint16, ipv4-address`")
+	}
+	for _, f := range strings.Split(fields, ",") {
+		f = strings.TrimSpace(f)
+		if f == "record" || f == "empty" || !slices.Contains(OptionDataTypes, f) {
+			return fmt.Errorf("record field type `%s` is not a valid option data type", f)
+		}
+	}
+	return nil
+}
+
+// ValidateData : Validates option-data against the definition. With csvFormat, data is a comma
+// separated list of values, `\,` escaping a comma, otherwise it is raw hexadecimal. Types that Kea
+// parses itself, such as `internal`, are not checked.
+func (d RemoteOptionDef4) ValidateData(data string, csvFormat bool) error {
+	if !csvFormat || d.Type == "binary" {
+		return validateHex(data)
+	}
+
+	values := splitOptionData(data)
+	switch d.Type {
+	case "empty":
+		if len(values) > 0 {
+			return fmt.Errorf("option `%s` carries no data, got `%s`", d.Name, data)
+		}
+		return nil
+	case "string", "fqdn", "tuple":
+		if !d.Array {
+			return nil
+		}
+	case "record":
+		return d.validateRecord(values)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("option `%s` requires a %s value", d.Name, d.Type)
+	}
+	if !d.Array && len(values) > 1 {
+		return fmt.Errorf("option `%s` takes a single %s value, got %d values", d.Name, d.Type, len(values))
+	}
+	for _, v := range values {
+		if err := validateOptionValue(d.Type, v); err != nil {
+			return fmt.Errorf("option `%s`: %w", d.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateRecord : Validates the values of a record option against its record-types, the last field
+// repeats when the record is an array.
+func (d RemoteOptionDef4) validateRecord(values []string) error {
+	fields := make([]string, 0)
+	for _, f := range strings.Split(d.RecordTypes, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if len(values) < len(fields) || (!d.Array && len(values) > len(fields)) {
+		return fmt.Errorf("option `%s` takes the record (%s), got %d values", d.Name, d.RecordTypes, len(values))
+	}
+	for i, v := range values {
+		t := fields[min(i, len(fields)-1)]
+		if err := validateOptionValue(t, v); err != nil {
+			return fmt.Errorf("option `%s` field %d: %w", d.Name, i+1, err)
+		}
+	}
+	return nil
+}
+
+// splitOptionData : Splits comma separated option-data into trimmed values, `\,` escapes a comma.
+func splitOptionData(data string) []string {
+	if strings.TrimSpace(data) == "" {
+		return nil
+	}
+	values := make([]string, 0)
+	var b strings.Builder
+	for i := 0; i < len(data); i++ {
+		switch {
+		case data[i] == '\\' && i+1 < len(data) && data[i+1] == ',':
+			b.WriteByte(',')
+			i++
+		case data[i] == ',':
+			values = append(values, strings.TrimSpace(b.String()))
+			b.Reset()
+		default:
+			b.WriteByte(data[i])
+		}
+	}
+	return append(values, strings.TrimSpace(b.String()))
+}
+
+// validateOptionValue : Validates a single value of the given option data type.
+func validateOptionValue(typ, v string) error {
+	switch typ {
+	case "boolean":
+		switch strings.ToLower(v) {
+		case "true", "false", "0", "1":
+			return nil
+		}
+		return fmt.Errorf("`%s` is not a boolean", v)
+	case "int8", "int16", "int32":
+		bits, _ := strconv.Atoi(strings.TrimPrefix(typ, "int"))
+		if _, err := strconv.ParseInt(v, 0, bits); err != nil {
+			return fmt.Errorf("`%s` is not an %s", v, typ)
+		}
+	case "uint8", "uint16", "uint32":
+		bits, _ := strconv.Atoi(strings.TrimPrefix(typ, "uint"))
+		if _, err := strconv.ParseUint(v, 0, bits); err != nil {
+			return fmt.Errorf("`%s` is not a %s", v, typ)
+		}
+	case "ipv4-address":
+		if ip := net.ParseIP(v); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("`%s` is not an IPv4 address", v)
+		}
+	case "ipv6-address":
+		if ip := net.ParseIP(v); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("`%s` is not an IPv6 address", v)
+		}
+	case "ipv6-prefix":
+		if ip, _, err := net.ParseCIDR(v); err != nil || ip.To4() != nil {
+			return fmt.Errorf("`%s` is not an IPv6 prefix", v)
+		}
+	case "psid":
+		psid, length, ok := strings.Cut(v, "/")
+		if !ok {
+			return fmt.Errorf("`%s` is not a PSID, e.g. `3/4`", v)
+		}
+		if _, err := strconv.ParseUint(psid, 10, 16); err != nil {
+			return fmt.Errorf("`%s` is not a PSID, e.g. `3/4`", v)
+		}
+		if l, err := strconv.ParseUint(length, 10, 8); err != nil || l > 16 {
+			return fmt.Errorf("`%s` is not a PSID, e.g. `3/4`", v)
+		}
+	case "binary":
+		return validateHex(v)
+	}
+	return nil
+}
+
+// validateHex : Validates hexadecimal data, which may start with 0x and separate octets with colons or spaces.
+func validateHex(v string) error {
+	s := strings.TrimPrefix(strings.TrimPrefix(v, "0x"), "0X")
+	s = strings.NewReplacer(":", "", " ", "").Replace(s)
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return fmt.Errorf("`%s` is not hexadecimal data", v)
+	}
+	return nil
+}
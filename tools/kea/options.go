@@ -18,7 +18,8 @@ type (
 		logLevel    *logrus.Level
 		proxyURL    *string
 		auth        *auth
-		remote      *string
+		remote      *Remote
+		pullServers *[]string
 	}
 
 	// Option : Basic options allowed with this client.
@@ -56,13 +57,22 @@ func WithAuth(user, pass string) Option {
 	}
 }
 
-// WithRemote : Will set a default remote to use with configuration-backend commands. Default postgresql.
-func WithRemote(remote string) Option {
+// WithRemote : Will set the default remote to use with configuration-backend commands. The type
+// defaults to postgresql when empty.
+func WithRemote(remote Remote) Option {
 	return func(o *options) {
 		o.remote = &remote
 	}
 }
 
+// WithPullAfterApply : Will send config-backend-pull after every configuration-backend write made
+// through the provider. Servers defaults to the hostname that received the write.
+func WithPullAfterApply(servers ...string) Option {
+	return func(o *options) {
+		o.pullServers = &servers
+	}
+}
+
 func (c *Client) processOptions(opts ...Option) {
 	o := new(options)
 	for _, opt := range opts {
@@ -77,10 +87,17 @@ func (c *Client) processOptions(opts ...Option) {
 		c.client.Timeout = time.Duration(*o.httpTimeout) * time.Second
 	}
 
-	c.remote = "postgresql"
 	if o.remote != nil {
 		c.remote = *o.remote
 	}
+	if c.remote.Type == "" {
+		c.remote.Type = RemoteTypePostgreSQL
+	}
+
+	if o.pullServers != nil {
+		c.pullAfterApply = true
+		c.pullServers = *o.pullServers
+	}
 
 	if o.proxyURL != nil {
 		pURL, err := url.Parse(*o.proxyURL)
@@ -0,0 +1,127 @@
+package kea
+
+import (
+	"errors"
+	"net/http"
+)
+
+type (
+	// RemoteClientClass6 : Represents a single dhcp6 client class entry in Kea.
+	RemoteClientClass6 struct {
+		Name              string         `json:"name"`
+		Metadata          *Metadata      `json:"metadata,omitempty"`
+		Test              string         `json:"test,omitempty"`
+		OnlyIfRequired    *bool          `json:"only-if-required,omitempty"`
+		OptionData        []OptionData   `json:"option-data"`
+		PreferredLifetime *int           `json:"preferred-lifetime,omitempty"`
+		ValidLifetime     *int           `json:"valid-lifetime,omitempty"`
+		UserContext       map[string]any `json:"user-context,omitempty"`
+	}
+)
+
+// RemoteClass6Set : Creates or replaces a dhcp6 client class using the Kea configuration-backend commands API.
+// A new class is appended to the end of the class hierarchy.
+func (c *Client) RemoteClass6Set(hostname string, class RemoteClientClass6) error {
+	class.Metadata = nil
+	payload := Request{
+		Command: "remote-class6-set",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":         c.remote,
+			"server-tags":    []string{"all"},
+			"client-classes": []RemoteClientClass6{class},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteClass6Get : Gets a dhcp6 client class by name from the Kea configuration-backend commands API.
+func (c *Client) RemoteClass6Get(hostname, name string) (RemoteClientClass6, error) {
+	payload := Request{
+		Command: "remote-class6-get",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":         c.remote,
+			"client-classes": []map[string]string{{"name": name}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return RemoteClientClass6{}, err
+	}
+
+	var ret struct {
+		ClientClasses []RemoteClientClass6 `json:"client-classes"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		return RemoteClientClass6{}, err
+	}
+	if len(ret.ClientClasses) == 0 {
+		return RemoteClientClass6{}, ErrNotFound
+	}
+	return ret.ClientClasses[0], nil
+}
+
+// RemoteClass6Del : Deletes a dhcp6 client class from the Kea configuration-backend commands API.
+func (c *Client) RemoteClass6Del(hostname, name string) error {
+	payload := Request{
+		Command: "remote-class6-del",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":         c.remote,
+			"client-classes": []map[string]string{{"name": name}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteClass6GetAll : Gets every dhcp6 client class from the Kea configuration-backend commands API,
+// in the order of the class hierarchy.
+func (c *Client) RemoteClass6GetAll(hostname string) ([]RemoteClientClass6, error) {
+	payload := Request{
+		Command: "remote-class6-get-all",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		ClientClasses []RemoteClientClass6 `json:"client-classes"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		// An empty configuration-backend is not an error for a list.
+		if errors.Is(err, ErrNotFound) {
+			return []RemoteClientClass6{}, nil
+		}
+		return nil, err
+	}
+	return ret.ClientClasses, nil
+}
@@ -0,0 +1,150 @@
+package kea
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// RemoteGlobalParameter6Set : Sets a dhcp6 global parameter using the Kea configuration-backend commands API.
+// The value is sent as is, so numbers and booleans must be passed as such.
+func (c *Client) RemoteGlobalParameter6Set(hostname, name string, value any) error {
+	payload := Request{
+		Command: "remote-global-parameter6-set",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"parameters":  map[string]any{name: value},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteGlobalParameter6Get : Gets a dhcp6 global parameter from the Kea configuration-backend commands API.
+// Numbers are returned as json.Number, and nil is returned when the parameter is not set.
+func (c *Client) RemoteGlobalParameter6Get(hostname, name string) (any, error) {
+	payload := Request{
+		Command: "remote-global-parameter6-get",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"parameters":  []string{name},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Count      int                        `json:"count"`
+		Parameters map[string]json.RawMessage `json:"parameters"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	raw, ok := ret.Parameters[name]
+	if !ok {
+		return nil, nil
+	}
+	return decodeParameter(raw)
+}
+
+// RemoteGlobalParameter6Del : Deletes a dhcp6 global parameter from the Kea configuration-backend commands API,
+// so that the servers fall back to their configuration file or built-in default.
+func (c *Client) RemoteGlobalParameter6Del(hostname, name string) error {
+	payload := Request{
+		Command: "remote-global-parameter6-del",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"parameters":  []string{name},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteGlobalParameter6GetAll : Gets every dhcp6 global parameter from the Kea configuration-backend commands API,
+// keyed by parameter name.
+func (c *Client) RemoteGlobalParameter6GetAll(hostname string) (map[string]any, error) {
+	payload := Request{
+		Command: "remote-global-parameter6-get-all",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Count      int                          `json:"count"`
+		Parameters []map[string]json.RawMessage `json:"parameters"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return map[string]any{}, nil
+		}
+		return nil, err
+	}
+
+	params := make(map[string]any, len(ret.Parameters))
+	for _, p := range ret.Parameters {
+		for k, raw := range p {
+			// Every parameter carries the server tags it applies to.
+			if k == "metadata" {
+				continue
+			}
+			v, err := decodeParameter(raw)
+			if err != nil {
+				return nil, err
+			}
+			params[k] = v
+		}
+	}
+	return params, nil
+}
+
+// decodeParameter : Decodes a global parameter value, keeping numbers as json.Number so that
+// integers are not turned into floats.
+func decodeParameter(raw json.RawMessage) (any, error) {
+	var v any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,139 @@
+package kea
+
+import (
+	"errors"
+	"net/http"
+)
+
+type (
+	// RemoteNetwork6 : Represents a single dhcp6 shared-network entry in Kea.
+	RemoteNetwork6 struct {
+		Name                 string         `json:"name"`
+		Metadata             *Metadata      `json:"metadata,omitempty"`
+		Interface            string         `json:"interface,omitempty"`
+		Relay                Relay          `json:"relay,omitempty"`
+		OptionData           []OptionData   `json:"option-data"`
+		ClientClass          string         `json:"client-class,omitempty"`
+		RequireClientClasses []string       `json:"require-client-classes,omitempty"`
+		RapidCommit          *bool          `json:"rapid-commit,omitempty"`
+		PreferredLifetime    *int           `json:"preferred-lifetime,omitempty"`
+		ValidLifetime        *int           `json:"valid-lifetime,omitempty"`
+		RenewTimer           *int           `json:"renew-timer,omitempty"`
+		RebindTimer          *int           `json:"rebind-timer,omitempty"`
+		UserContext          map[string]any `json:"user-context,omitempty"`
+	}
+
+	// RemoteNetwork6List : Represents a single dhcp6 shared-network entry in a Kea list response.
+	RemoteNetwork6List struct {
+		Name     string   `json:"name"`
+		Metadata Metadata `json:"metadata"`
+	}
+)
+
+// RemoteNetwork6List : Gets the list of dhcp6 shared-networks from the Kea configuration-backend commands API.
+func (c *Client) RemoteNetwork6List(hostname string) ([]RemoteNetwork6List, error) {
+	payload := Request{
+		Command: "remote-network6-list",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		SharedNetworks []RemoteNetwork6List `json:"shared-networks"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		// An empty configuration-backend is not an error for a list.
+		if errors.Is(err, ErrNotFound) {
+			return []RemoteNetwork6List{}, nil
+		}
+		return nil, err
+	}
+	return ret.SharedNetworks, nil
+}
+
+// RemoteNetwork6Get : Gets a dhcp6 shared-network by name from the Kea configuration-backend commands API.
+func (c *Client) RemoteNetwork6Get(hostname, name string) (RemoteNetwork6, error) {
+	payload := Request{
+		Command: "remote-network6-get",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":          c.remote,
+			"shared-networks": []map[string]string{{"name": name}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return RemoteNetwork6{}, err
+	}
+
+	var ret struct {
+		SharedNetworks []RemoteNetwork6 `json:"shared-networks"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		return RemoteNetwork6{}, err
+	}
+	if len(ret.SharedNetworks) == 0 {
+		return RemoteNetwork6{}, ErrNotFound
+	}
+	return ret.SharedNetworks[0], nil
+}
+
+// RemoteNetwork6Set : Creates or replaces a dhcp6 shared-network using the Kea configuration-backend commands API.
+// Subnets are not part of the shared-network, they reference it by name.
+func (c *Client) RemoteNetwork6Set(hostname string, network RemoteNetwork6) error {
+	network.Metadata = nil
+	payload := Request{
+		Command: "remote-network6-set",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":          c.remote,
+			"server-tags":     []string{"all"},
+			"shared-networks": []RemoteNetwork6{network},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteNetwork6Del : Deletes a dhcp6 shared-network from the Kea configuration-backend commands API.
+// Its subnets are kept and no longer belong to any shared-network.
+func (c *Client) RemoteNetwork6Del(hostname, name string) error {
+	payload := Request{
+		Command: "remote-network6-del",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":          c.remote,
+			"shared-networks": []map[string]string{{"name": name}},
+			"subnets-action":  "keep",
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
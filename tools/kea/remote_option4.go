@@ -18,7 +18,7 @@ func (c *Client) RemoteOption4Set(hostname string, subnetID int, opts []OptionRe
 		Command: "remote-option4-subnet-set",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]int{{"id": subnetID}},
 			"options": opts,
 		},
@@ -44,7 +44,7 @@ func (c *Client) RemoteOption4Del(hostname string, subnetID int, opts []OptionRe
 		Command: "remote-option4-subnet-del",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]int{{"id": subnetID}},
 			"options": opts,
 		},
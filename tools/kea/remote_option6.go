@@ -0,0 +1,157 @@
+package kea
+
+import (
+	"errors"
+	"net/http"
+)
+
+type (
+	// optionSelector : Identifies a single option-data entry to get or delete, by its code and space.
+	optionSelector struct {
+		Code  int    `json:"code"`
+		Space string `json:"space"`
+	}
+)
+
+// RemoteOption6GlobalSet : Sets a global dhcp6 option in the configuration-backend.
+func (c *Client) RemoteOption6GlobalSet(hostname string, opt OptionData) error {
+	return c.remoteOption6(hostname, "remote-option6-global-set", map[string]any{
+		"server-tags": []string{"all"},
+		"options":     []OptionData{opt},
+	}, nil)
+}
+
+// RemoteOption6GlobalGet : Gets a global dhcp6 option from the configuration-backend. A nil option
+// is returned when the option is not set.
+func (c *Client) RemoteOption6GlobalGet(hostname, space string, code int) (*OptionData, error) {
+	var ret struct {
+		Count   int          `json:"count"`
+		Options []OptionData `json:"options"`
+	}
+	err := c.remoteOption6(hostname, "remote-option6-global-get", map[string]any{
+		"server-tags": []string{"all"},
+		"options":     []optionSelector{{Code: code, Space: space}},
+	}, &ret)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(ret.Options) == 0 {
+		return nil, nil
+	}
+	return &ret.Options[0], nil
+}
+
+// RemoteOption6GlobalDel : Deletes a global dhcp6 option from the configuration-backend.
+func (c *Client) RemoteOption6GlobalDel(hostname, space string, code int) error {
+	return c.remoteOption6(hostname, "remote-option6-global-del", map[string]any{
+		"server-tags": []string{"all"},
+		"options":     []optionSelector{{Code: code, Space: space}},
+	}, nil)
+}
+
+// RemoteOption6NetworkSet : Sets a dhcp6 option on a shared-network in the configuration-backend.
+func (c *Client) RemoteOption6NetworkSet(hostname, network string, opt OptionData) error {
+	return c.remoteOption6(hostname, "remote-option6-network-set", map[string]any{
+		"shared-networks": []map[string]string{{"name": network}},
+		"options":         []OptionData{opt},
+	}, nil)
+}
+
+// RemoteOption6NetworkDel : Deletes a dhcp6 option from a shared-network in the configuration-backend.
+func (c *Client) RemoteOption6NetworkDel(hostname, network, space string, code int) error {
+	return c.remoteOption6(hostname, "remote-option6-network-del", map[string]any{
+		"shared-networks": []map[string]string{{"name": network}},
+		"options":         []optionSelector{{Code: code, Space: space}},
+	}, nil)
+}
+
+// RemoteOption6SubnetSet : Sets a dhcp6 option on a subnet in the configuration-backend.
+func (c *Client) RemoteOption6SubnetSet(hostname string, subnetID int, opt OptionData) error {
+	return c.remoteOption6(hostname, "remote-option6-subnet-set", map[string]any{
+		"subnets": []map[string]int{{"id": subnetID}},
+		"options": []OptionData{opt},
+	}, nil)
+}
+
+// RemoteOption6SubnetDel : Deletes a dhcp6 option from a subnet in the configuration-backend.
+func (c *Client) RemoteOption6SubnetDel(hostname string, subnetID int, space string, code int) error {
+	return c.remoteOption6(hostname, "remote-option6-subnet-del", map[string]any{
+		"subnets": []map[string]int{{"id": subnetID}},
+		"options": []optionSelector{{Code: code, Space: space}},
+	}, nil)
+}
+
+// RemoteOption6PoolSet : Sets a dhcp6 option on an address pool, e.g. `2001:db8::10-2001:db8::ff`,
+// in the configuration-backend.
+func (c *Client) RemoteOption6PoolSet(hostname, pool string, opt OptionData) error {
+	return c.remoteOption6(hostname, "remote-option6-pool-set", map[string]any{
+		"pools":   []map[string]string{{"pool": pool}},
+		"options": []OptionData{opt},
+	}, nil)
+}
+
+// RemoteOption6PoolDel : Deletes a dhcp6 option from an address pool in the configuration-backend.
+func (c *Client) RemoteOption6PoolDel(hostname, pool, space string, code int) error {
+	return c.remoteOption6(hostname, "remote-option6-pool-del", map[string]any{
+		"pools":   []map[string]string{{"pool": pool}},
+		"options": []optionSelector{{Code: code, Space: space}},
+	}, nil)
+}
+
+// RemoteOption6PDPoolSet : Sets a dhcp6 option on a prefix delegation pool, identified by its
+// prefix e.g. `2001:db8:8000::`, in the configuration-backend.
+func (c *Client) RemoteOption6PDPoolSet(hostname, prefix string, opt OptionData) error {
+	return c.remoteOption6(hostname, "remote-option6-pd-pool-set", map[string]any{
+		"pd-pools": []map[string]string{{"prefix": prefix}},
+		"options":  []OptionData{opt},
+	}, nil)
+}
+
+// RemoteOption6PDPoolDel : Deletes a dhcp6 option from a prefix delegation pool in the configuration-backend.
+func (c *Client) RemoteOption6PDPoolDel(hostname, prefix, space string, code int) error {
+	return c.remoteOption6(hostname, "remote-option6-pd-pool-del", map[string]any{
+		"pd-pools": []map[string]string{{"prefix": prefix}},
+		"options":  []optionSelector{{Code: code, Space: space}},
+	}, nil)
+}
+
+// remoteOption6 : Sends a remote-option6 command with the given arguments, adding the remote selector.
+func (c *Client) remoteOption6(hostname, command string, args map[string]any, v interface{}) error {
+	args["remote"] = c.remote
+	payload := Request{
+		Command:   command,
+		Service:   []string{"dhcp6"},
+		Arguments: args,
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		var ret interface{}
+		v = &ret
+	}
+	if _, err := c.do(req, v); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindOption : Returns the option-data entry with the given code and space, options without a space
+// belonging to defaultSpace. A nil option is returned when there is none.
+func FindOption(opts []OptionData, defaultSpace, space string, code int) *OptionData {
+	for i, o := range opts {
+		s := defaultSpace
+		if o.Space != nil && *o.Space != "" {
+			s = *o.Space
+		}
+		if o.Code != nil && *o.Code == code && s == space {
+			return &opts[i]
+		}
+	}
+	return nil
+}
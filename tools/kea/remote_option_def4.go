@@ -1,8 +1,8 @@
 package kea
 
 import (
+	"errors"
 	"net/http"
-	"strings"
 )
 
 type (
@@ -24,7 +24,7 @@ func (c *Client) RemoteOptionDef4Set(hostname string, def RemoteOptionDef4) erro
 		Command: "remote-option-def4-set",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":      map[string]string{"type": c.remote},
+			"remote":      c.remote,
 			"server-tags": []string{"all"},
 			"option-defs": []RemoteOptionDef4{def},
 		},
@@ -48,7 +48,7 @@ func (c *Client) RemoteOptionDef4Get(hostname, space string, code int) (*RemoteO
 		Command: "remote-option-def4-get",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":      map[string]string{"type": c.remote},
+			"remote":      c.remote,
 			"server-tags": []string{"all"},
 			"option-defs": []RemoteOptionDef4{{Space: space, Code: code}},
 		},
@@ -65,7 +65,7 @@ func (c *Client) RemoteOptionDef4Get(hostname, space string, code int) (*RemoteO
 
 	ret := new(optDefResp)
 	if _, err := c.do(req, ret); err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, nil
 		}
 		return nil, err
@@ -82,7 +82,7 @@ func (c *Client) RemoteOptionDef4Del(hostname, space string, code int) error {
 		Command: "remote-option-def4-del",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":      map[string]string{"type": c.remote},
+			"remote":      c.remote,
 			"server-tags": []string{"all"},
 			"option-defs": []RemoteOptionDef4{{Space: space, Code: code}},
 		},
@@ -99,3 +99,32 @@ func (c *Client) RemoteOptionDef4Del(hostname, space string, code int) error {
 	}
 	return nil
 }
+
+// RemoteOptionDef4GetAll : Gets all remote option definitions from the dhcp4 configuration.
+func (c *Client) RemoteOptionDef4GetAll(hostname string) ([]RemoteOptionDef4, error) {
+	payload := Request{
+		Command: "remote-option-def4-get-all",
+		Service: []string{"dhcp4"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Count      int                `json:"count"`
+		OptionDefs []RemoteOptionDef4 `json:"option-defs"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ret.OptionDefs, nil
+}
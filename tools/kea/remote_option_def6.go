@@ -0,0 +1,122 @@
+package kea
+
+import (
+	"net/http"
+	"strings"
+)
+
+type (
+	// RemoteOptionDef6 : Represents a single remote dhcp6 option definition entry in Kea. It carries
+	// the same fields as RemoteOptionDef4, so the two convert into each other.
+	RemoteOptionDef6 RemoteOptionDef4
+)
+
+// RemoteOptionDef6Set : Sets the remote option definition for the dhcp6 configuration.
+func (c *Client) RemoteOptionDef6Set(hostname string, def RemoteOptionDef6) error {
+	payload := Request{
+		Command: "remote-option-def6-set",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"option-defs": []RemoteOptionDef6{def},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteOptionDef6Get : Gets the remote option definition from the dhcp6 configuration.
+func (c *Client) RemoteOptionDef6Get(hostname, space string, code int) (*RemoteOptionDef6, error) {
+	payload := Request{
+		Command: "remote-option-def6-get",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"option-defs": []RemoteOptionDef6{{Space: space, Code: code}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Count      int                `json:"count"`
+		OptionDefs []RemoteOptionDef6 `json:"option-defs"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(ret.OptionDefs) == 0 {
+		return nil, nil
+	}
+	return &ret.OptionDefs[0], nil
+}
+
+// RemoteOptionDef6Del : Deletes the remote option definition from the dhcp6 configuration.
+func (c *Client) RemoteOptionDef6Del(hostname, space string, code int) error {
+	payload := Request{
+		Command: "remote-option-def6-del",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+			"option-defs": []RemoteOptionDef6{{Space: space, Code: code}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoteOptionDef6GetAll : Gets all remote option definitions from the dhcp6 configuration.
+func (c *Client) RemoteOptionDef6GetAll(hostname string) ([]RemoteOptionDef6, error) {
+	payload := Request{
+		Command: "remote-option-def6-get-all",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": []string{"all"},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Count      int                `json:"count"`
+		OptionDefs []RemoteOptionDef6 `json:"option-defs"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ret.OptionDefs, nil
+}
@@ -0,0 +1,168 @@
+package kea
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RemoteSubnet4PoolSet : Adds a pool to the subnet with the given prefix, or replaces the pool with the same
+// range, leaving the rest of the subnet as it is. Kea has no command for a single pool, so the whole subnet
+// is read and written back.
+func (c *Client) RemoteSubnet4PoolSet(hostname, prefix string, pool Pool) error {
+	b, err := json.Marshal(pool)
+	if err != nil {
+		return err
+	}
+	var entry map[string]any
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return err
+	}
+
+	return c.RemoteSubnet4Modify(hostname, prefix, func(subnet map[string]any) error {
+		pools, _ := subnet["pools"].([]any)
+		for i, p := range pools {
+			if samePool(poolRange(p), pool.Pool) {
+				pools[i] = entry
+				return nil
+			}
+		}
+		subnet["pools"] = append(pools, entry)
+		return nil
+	})
+}
+
+// RemoteSubnet4PoolDel : Removes the pool with the given range from the subnet with the given prefix, leaving
+// the rest of the subnet as it is. A pool that is not in the subnet is not an error.
+func (c *Client) RemoteSubnet4PoolDel(hostname, prefix, pool string) error {
+	return c.RemoteSubnet4Modify(hostname, prefix, func(subnet map[string]any) error {
+		pools, _ := subnet["pools"].([]any)
+		kept := make([]any, 0, len(pools))
+		for _, p := range pools {
+			if !samePool(poolRange(p), pool) {
+				kept = append(kept, p)
+			}
+		}
+		subnet["pools"] = kept
+		return nil
+	})
+}
+
+// RemoteSubnet4Modify : Reads the subnet with the given prefix as a JSON object, applies fn and writes it back,
+// unless fn returns an error. Working on the JSON object keeps the parameters RemoteSubnet4 does not model.
+func (c *Client) RemoteSubnet4Modify(hostname, prefix string, fn func(subnet map[string]any) error) error {
+	subnet, err := c.RemoteSubnet4GetObject(hostname, prefix)
+	if err != nil {
+		return err
+	}
+	if err := fn(subnet); err != nil {
+		return err
+	}
+
+	// The metadata is reported by Kea, but not accepted when setting the subnet. It carries the server
+	// tags of the subnet, which are kept when writing it back.
+	tags := metadataServerTags(subnet["metadata"])
+	delete(subnet, "metadata")
+	payload := Request{
+		Command: "remote-subnet4-set",
+		Service: []string{"dhcp4"},
+		Arguments: map[string]any{
+			"remote":      c.remote,
+			"server-tags": tags,
+			"subnets":     []map[string]any{subnet},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// metadataServerTags : Returns the server tags from the metadata of an object read from the configuration-backend,
+// falling back to `all` when it reports none.
+func metadataServerTags(metadata any) []string {
+	m, _ := metadata.(map[string]any)
+	list, _ := m["server-tags"].([]any)
+	tags := make([]string, 0, len(list))
+	for _, t := range list {
+		if s, ok := t.(string); ok && s != "" {
+			tags = append(tags, s)
+		}
+	}
+	if len(tags) == 0 {
+		return []string{"all"}
+	}
+	return tags
+}
+
+// RemoteSubnet4GetObject : Gets the subnet with the given prefix as a JSON object, with numbers kept as json.Number.
+func (c *Client) RemoteSubnet4GetObject(hostname, prefix string) (map[string]any, error) {
+	payload := Request{
+		Command: "remote-subnet4-get-by-prefix",
+		Service: []string{"dhcp4"},
+		Arguments: map[string]any{
+			"remote":  c.remote,
+			"subnets": []map[string]string{{"subnet": prefix}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Subnets []json.RawMessage `json:"subnets"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		return nil, err
+	}
+	if len(ret.Subnets) == 0 {
+		return nil, ErrNotFound
+	}
+
+	var subnet map[string]any
+	dec := json.NewDecoder(bytes.NewReader(ret.Subnets[0]))
+	dec.UseNumber()
+	if err := dec.Decode(&subnet); err != nil {
+		return nil, err
+	}
+	return subnet, nil
+}
+
+// poolRange : Returns the range of a pool JSON object.
+func poolRange(p any) string {
+	m, _ := p.(map[string]any)
+	s, _ := m["pool"].(string)
+	return s
+}
+
+// samePool : Reports whether two pools cover the same range, ignoring spaces and the spelling of addresses.
+func samePool(a, b string) bool {
+	return normalizePool(a) == normalizePool(b)
+}
+
+// normalizePool : Returns the canonical notation of a pool range or prefix, or the pool without spaces
+// when it is not valid.
+func normalizePool(p string) string {
+	p = strings.ReplaceAll(p, " ", "")
+	if _, network, err := net.ParseCIDR(p); err == nil {
+		return network.String()
+	}
+	if start, end, ok := strings.Cut(p, "-"); ok {
+		s, e := net.ParseIP(start), net.ParseIP(end)
+		if s != nil && e != nil {
+			return s.String() + "-" + e.String()
+		}
+	}
+	return p
+}
@@ -1,9 +1,25 @@
 package kea
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
 )
 
+// MaxSubnetID : Highest subnet ID accepted by Kea. IDs are uint32, with 0 and 4294967295 reserved.
+const MaxSubnetID = 4294967294
+
+// DHCP4OptionSpace : Option space of the standard DHCPv4 options, the default space of option-data.
+const DHCP4OptionSpace = "dhcp4"
+
+var (
+	// DDNSReplaceClientNameModes : Values of ddns-replace-client-name.
+	DDNSReplaceClientNameModes = []string{"never", "always", "when-present", "when-not-present"}
+	// DDNSConflictResolutionModes : Values of ddns-conflict-resolution-mode (Kea 2.5+).
+	DDNSConflictResolutionModes = []string{"check-with-dhcid", "no-check-with-dhcid", "check-exists-with-dhcid", "no-check-without-dhcid"}
+)
+
 type (
 	// RemoteSubnet4 : Represents a single subnet4 entry in Kea.
 	RemoteSubnet4 struct {
@@ -18,6 +34,10 @@ type (
 		SharedNetworkName interface{}            `json:"shared-network-name"`
 		Subnet            string                 `json:"subnet"`
 		UserContext       map[string]interface{} `json:"user-context"`
+		NextServer        string                 `json:"next-server"`
+		ServerHostname    string                 `json:"server-hostname"`
+		BootFileName      string                 `json:"boot-file-name"`
+		Subnet4Parameters
 	}
 
 	// RemoteSubnet4List : Represents a single subnet4 entry in Kea.
@@ -30,16 +50,58 @@ type (
 
 	// NewRemoteSubnet4 : Represents a single subnet4 entry in Kea.
 	NewRemoteSubnet4 struct {
-		ID                int               `json:"id"`
-		Subnet            string            `json:"subnet"`
-		SharedNetworkName *string           `json:"shared-network-name"`
-		Pools             []Pool            `json:"pools"`
-		OptionData        []OptionData      `json:"option-data"`
-		Relay             Relay             `json:"relay,omitempty"`
-		UserContext       map[string]string `json:"user-context,omitempty"`
-		NextServer        string            `json:"next-server,omitempty"`
-		ServerHostname    string            `json:"server-hostname,omitempty"`
-		BootFileName      string            `json:"boot-file-name,omitempty"`
+		ID                int            `json:"id"`
+		Subnet            string         `json:"subnet"`
+		SharedNetworkName *string        `json:"shared-network-name"`
+		Pools             []Pool         `json:"pools"`
+		OptionData        []OptionData   `json:"option-data"`
+		Relay             Relay          `json:"relay,omitempty"`
+		UserContext       map[string]any `json:"user-context,omitempty"`
+		NextServer        string         `json:"next-server,omitempty"`
+		ServerHostname    string         `json:"server-hostname,omitempty"`
+		BootFileName      string         `json:"boot-file-name,omitempty"`
+		Subnet4Parameters
+	}
+
+	// Subnet4Parameters : The optional lease, timer and host reservation parameters of a subnet4.
+	// A nil value is not sent, so that the subnet inherits it from the shared-network or global scope.
+	Subnet4Parameters struct {
+		ValidLifetime         *int     `json:"valid-lifetime,omitempty"`
+		MinValidLifetime      *int     `json:"min-valid-lifetime,omitempty"`
+		MaxValidLifetime      *int     `json:"max-valid-lifetime,omitempty"`
+		RenewTimer            *int     `json:"renew-timer,omitempty"`
+		RebindTimer           *int     `json:"rebind-timer,omitempty"`
+		CalculateTeeTimes     *bool    `json:"calculate-tee-times,omitempty"`
+		T1Percent             *float64 `json:"t1-percent,omitempty"`
+		T2Percent             *float64 `json:"t2-percent,omitempty"`
+		Authoritative         *bool    `json:"authoritative,omitempty"`
+		MatchClientID         *bool    `json:"match-client-id,omitempty"`
+		Interface             string   `json:"interface,omitempty"`
+		ReservationsGlobal    *bool    `json:"reservations-global,omitempty"`
+		ReservationsInSubnet  *bool    `json:"reservations-in-subnet,omitempty"`
+		ReservationsOutOfPool *bool    `json:"reservations-out-of-pool,omitempty"`
+		StoreExtendedInfo     *bool    `json:"store-extended-info,omitempty"`
+		CacheThreshold        *float64 `json:"cache-threshold,omitempty"`
+		CacheMaxAge           *int     `json:"cache-max-age,omitempty"`
+		// OfferLifetime : Lifetime of leases offered but not yet requested (Kea 2.6+).
+		OfferLifetime *int `json:"offer-lifetime,omitempty"`
+		DDNSParameters
+	}
+
+	// DDNSParameters : The optional dynamic DNS parameters of a subnet or shared-network.
+	DDNSParameters struct {
+		DDNSSendUpdates          *bool  `json:"ddns-send-updates,omitempty"`
+		DDNSOverrideClientUpdate *bool  `json:"ddns-override-client-update,omitempty"`
+		DDNSOverrideNoUpdate     *bool  `json:"ddns-override-no-update,omitempty"`
+		DDNSReplaceClientName    string `json:"ddns-replace-client-name,omitempty"`
+		DDNSGeneratedPrefix      string `json:"ddns-generated-prefix,omitempty"`
+		DDNSQualifyingSuffix     string `json:"ddns-qualifying-suffix,omitempty"`
+		DDNSUpdateOnRenew        *bool  `json:"ddns-update-on-renew,omitempty"`
+		// DDNSUseConflictResolution : Superseded by DDNSConflictResolutionMode in Kea 2.5+.
+		DDNSUseConflictResolution  *bool  `json:"ddns-use-conflict-resolution,omitempty"`
+		DDNSConflictResolutionMode string `json:"ddns-conflict-resolution-mode,omitempty"`
+		HostnameCharSet            string `json:"hostname-char-set,omitempty"`
+		HostnameCharReplacement    string `json:"hostname-char-replacement,omitempty"`
 	}
 
 	// Relay : Represents a single relay entry in Kea.
@@ -49,16 +111,24 @@ type (
 
 	// Pool : Represents a single pool entry in Kea.
 	Pool struct {
-		Pool string `json:"pool"`
+		Pool                 string         `json:"pool"`
+		OptionData           []OptionData   `json:"option-data,omitempty"`
+		ClientClass          string         `json:"client-class,omitempty"`
+		RequireClientClasses []string       `json:"require-client-classes,omitempty"`
+		UserContext          map[string]any `json:"user-context,omitempty"`
 	}
 
 	// OptionData : Represents a single option-data entry in Kea.
 	OptionData struct {
 		Code       *int    `json:"code,omitempty"`
 		Data       string  `json:"data"`
-		Name       string  `json:"name"`
+		Name       string  `json:"name,omitempty"`
 		Space      *string `json:"space,omitempty"`
 		AlwaysSend bool    `json:"always-send"`
+		// CSVFormat : Whether Data is a comma separated list of values (nil, Kea's default) or raw hexadecimal.
+		CSVFormat *bool `json:"csv-format,omitempty"`
+		// NeverSend : Whether the option is never sent, even when requested (Kea 2.2+).
+		NeverSend *bool `json:"never-send,omitempty"`
 	}
 )
 
@@ -70,7 +140,7 @@ func (c *Client) RemoteSubnet4List(hostname string) ([]RemoteSubnet4List, error)
 		Command: "remote-subnet4-list",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":      map[string]string{"type": c.remote},
+			"remote":      c.remote,
 			"server-tags": []string{"all"},
 		},
 	}
@@ -84,6 +154,10 @@ func (c *Client) RemoteSubnet4List(hostname string) ([]RemoteSubnet4List, error)
 		Subnets []RemoteSubnet4List `json:"subnets"`
 	}
 	if _, err := c.do(req, &ret); err != nil {
+		// An empty configuration-backend is not an error for a list.
+		if errors.Is(err, ErrNotFound) {
+			return []RemoteSubnet4List{}, nil
+		}
 		return nil, err
 	}
 	return ret.Subnets, nil
@@ -97,7 +171,7 @@ func (c *Client) RemoteSubnet4GetByPrefix(hostname, prefix string) (RemoteSubnet
 		Command: "remote-subnet4-get-by-prefix",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]string{{"subnet": prefix}},
 		},
 	}
@@ -113,6 +187,9 @@ func (c *Client) RemoteSubnet4GetByPrefix(hostname, prefix string) (RemoteSubnet
 	if _, err := c.do(req, &ret); err != nil {
 		return RemoteSubnet4{}, err
 	}
+	if len(ret.Subnets) == 0 {
+		return RemoteSubnet4{}, ErrNotFound
+	}
 	return ret.Subnets[0], nil
 }
 
@@ -124,7 +201,7 @@ func (c *Client) RemoteSubnet4GetByID(hostname string, id int) (RemoteSubnet4, e
 		Command: "remote-subnet4-get-by-id",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]int{{"id": id}},
 		},
 	}
@@ -140,6 +217,9 @@ func (c *Client) RemoteSubnet4GetByID(hostname string, id int) (RemoteSubnet4, e
 	if _, err := c.do(req, &ret); err != nil {
 		return RemoteSubnet4{}, err
 	}
+	if len(ret.Subnets) == 0 {
+		return RemoteSubnet4{}, ErrNotFound
+	}
 	return ret.Subnets[0], nil
 }
 
@@ -149,7 +229,7 @@ func (c *Client) RemoteSubnet4DelByPrefix(hostname, prefix string) (int, error)
 		Command: "remote-subnet4-del-by-prefix",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]string{{"subnet": prefix}},
 		},
 	}
@@ -174,7 +254,7 @@ func (c *Client) RemoteSubnet4DelByID(hostname string, id int) (int, error) {
 		Command: "remote-subnet4-del-by-id",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":  map[string]string{"type": c.remote},
+			"remote":  c.remote,
 			"subnets": []map[string]int{{"id": id}},
 		},
 	}
@@ -199,7 +279,7 @@ func (c *Client) RemoteSubnet4Set(hostname string, subnets []NewRemoteSubnet4) (
 		Command: "remote-subnet4-set",
 		Service: []string{"dhcp4"},
 		Arguments: map[string]any{
-			"remote":      map[string]string{"type": c.remote},
+			"remote":      c.remote,
 			"server-tags": []string{"all"},
 			"subnets":     subnets,
 		},
@@ -218,3 +298,36 @@ func (c *Client) RemoteSubnet4Set(hostname string, subnets []NewRemoteSubnet4) (
 	}
 	return ret.Subnets, nil
 }
+
+// RemoteSubnet4NextFreeID : Returns the lowest subnet ID that is not used by any subnet in the
+// configuration-backend. If the prefix already exists, its current ID is returned instead.
+func (c *Client) RemoteSubnet4NextFreeID(hostname, prefix string) (int, error) {
+	subnets, err := c.RemoteSubnet4List(hostname)
+	if err != nil {
+		return 0, err
+	}
+
+	used := make(map[int]struct{}, len(subnets))
+	for _, s := range subnets {
+		if SamePrefix(s.Subnet, prefix) {
+			return s.ID, nil
+		}
+		used[s.ID] = struct{}{}
+	}
+	for id := 1; id <= MaxSubnetID; id++ {
+		if _, ok := used[id]; !ok {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: no free subnet ID left", ErrInvalidSubnet)
+}
+
+// SamePrefix : Compares two prefixes, ignoring differences in notation.
+func SamePrefix(a, b string) bool {
+	_, an, aErr := net.ParseCIDR(a)
+	_, bn, bErr := net.ParseCIDR(b)
+	if aErr != nil || bErr != nil {
+		return a == b
+	}
+	return an.String() == bn.String()
+}
@@ -0,0 +1,60 @@
+package kea
+
+import (
+	"net/http"
+)
+
+type (
+	// RemoteSubnet6 : Represents a single subnet6 entry in Kea.
+	RemoteSubnet6 struct {
+		ID                int            `json:"id"`
+		Subnet            string         `json:"subnet"`
+		SharedNetworkName interface{}    `json:"shared-network-name"`
+		OptionData        []OptionData   `json:"option-data"`
+		Pools             []Pool6        `json:"pools"`
+		PDPools           []PDPool6      `json:"pd-pools"`
+		UserContext       map[string]any `json:"user-context,omitempty"`
+	}
+
+	// Pool6 : Represents a single dhcp6 address pool entry in Kea.
+	Pool6 struct {
+		Pool       string       `json:"pool"`
+		OptionData []OptionData `json:"option-data,omitempty"`
+	}
+
+	// PDPool6 : Represents a single dhcp6 prefix delegation pool entry in Kea.
+	PDPool6 struct {
+		Prefix       string       `json:"prefix"`
+		PrefixLen    int          `json:"prefix-len"`
+		DelegatedLen int          `json:"delegated-len"`
+		OptionData   []OptionData `json:"option-data,omitempty"`
+	}
+)
+
+// RemoteSubnet6GetByID : Gets a subnet6 by its ID from the Kea configuration-backend commands API.
+func (c *Client) RemoteSubnet6GetByID(hostname string, id int) (RemoteSubnet6, error) {
+	payload := Request{
+		Command: "remote-subnet6-get-by-id",
+		Service: []string{"dhcp6"},
+		Arguments: map[string]any{
+			"remote":  c.remote,
+			"subnets": []map[string]int{{"id": id}},
+		},
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return RemoteSubnet6{}, err
+	}
+
+	var ret struct {
+		Subnets []RemoteSubnet6 `json:"subnets"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		return RemoteSubnet6{}, err
+	}
+	if len(ret.Subnets) == 0 {
+		return RemoteSubnet6{}, ErrNotFound
+	}
+	return ret.Subnets[0], nil
+}
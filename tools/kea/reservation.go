@@ -1,36 +1,189 @@
 package kea
 
 import (
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
 )
 
+const (
+	// IdentifierHwAddress : Identifies a reservation by the client's hardware (MAC) address.
+	IdentifierHwAddress = "hw-address"
+	// IdentifierClientID : Identifies a reservation by the client identifier, DHCPv4 option 61.
+	IdentifierClientID = "client-id"
+	// IdentifierDUID : Identifies a reservation by the client's DUID.
+	IdentifierDUID = "duid"
+	// IdentifierCircuitID : Identifies a reservation by the relay agent circuit-id, DHCPv4 option 82 sub-option 1.
+	IdentifierCircuitID = "circuit-id"
+	// IdentifierFlexID : Identifies a reservation by the identifier computed by the flex-id hook.
+	IdentifierFlexID = "flex-id"
+	// IdentifierIPAddress : Looks a reservation up by its reserved IP address. This is not a host
+	// identifier, but it is accepted by ReservationGet and ReservationDel.
+	IdentifierIPAddress = "ip-address"
+)
+
+const (
+	// GlobalSubnetID : Subnet ID of global reservations, which apply to a client in any subnet.
+	GlobalSubnetID = 0
+
+	// OperationTargetMemory : Targets the reservations in the server configuration (Kea 2.4+).
+	OperationTargetMemory = "memory"
+	// OperationTargetDatabase : Targets the reservations in the hosts database, also known as the
+	// alternate sources (Kea 2.4+).
+	OperationTargetDatabase = "database"
+	// OperationTargetAll : Targets both the server configuration and the hosts database (Kea 2.4+).
+	OperationTargetAll = "all"
+	// OperationTargetDefault : Uses the default target of the command (Kea 2.4+).
+	OperationTargetDefault = "default"
+)
+
+// OperationTargets : Every operation-target supported by host_cmds.
+var OperationTargets = []string{OperationTargetMemory, OperationTargetDatabase, OperationTargetAll, OperationTargetDefault}
+
+// IdentifierTypes : Every host identifier type supported by Kea DHCPv4 reservations.
+var IdentifierTypes = []string{IdentifierHwAddress, IdentifierClientID, IdentifierDUID, IdentifierCircuitID, IdentifierFlexID}
+
 type (
 	// Reservation : Represents a single reservation entry in Kea.
 	Reservation struct {
-		BootFileName string         `json:"boot-file-name,omitempty"`
-		ClientID     string         `json:"client-id,omitempty"`
-		CircuitID    string         `json:"circuit-id,omitempty"`
-		DuID         string         `json:"duid,omitempty"`
-		FlexID       string         `json:"flex-id,omitempty"`
-		IPAddress    string         `json:"ip-address"`
-		HwAddress    string         `json:"hw-address"`
-		Hostname     string         `json:"hostname"`
-		NextServer   string         `json:"next-server,omitempty"`
-		OptionData   []OptionData   `json:"option-data,omitempty"`
-		SubnetID     int            `json:"subnet-id"`
-		UserContext  map[string]any `json:"user-context,omitempty"`
+		BootFileName   string         `json:"boot-file-name,omitempty"`
+		ClientClasses  []string       `json:"client-classes,omitempty"`
+		ClientID       string         `json:"client-id,omitempty"`
+		CircuitID      string         `json:"circuit-id,omitempty"`
+		DuID           string         `json:"duid,omitempty"`
+		FlexID         string         `json:"flex-id,omitempty"`
+		IPAddress      string         `json:"ip-address"`
+		HwAddress      string         `json:"hw-address,omitempty"`
+		Hostname       string         `json:"hostname"`
+		NextServer     string         `json:"next-server,omitempty"`
+		ServerHostname string         `json:"server-hostname,omitempty"`
+		OptionData     []OptionData   `json:"option-data,omitempty"`
+		SubnetID       int            `json:"subnet-id"`
+		UserContext    map[string]any `json:"user-context,omitempty"`
+	}
+
+	// ReservationOption : Optional arguments of the reservation commands.
+	ReservationOption func(args map[string]any)
+
+	// ReservationPage : A single page of reservations, as returned by reservation-get-page.
+	ReservationPage struct {
+		Count int                    `json:"count"`
+		Hosts []Reservation          `json:"hosts"`
+		Next  *ReservationPageCursor `json:"next,omitempty"`
+	}
+
+	// ReservationPageCursor : Position of the next page of reservations in the host data sources.
+	ReservationPageCursor struct {
+		From        int `json:"from"`
+		SourceIndex int `json:"source-index"`
 	}
 )
 
+// WithOperationTarget : Sets the `operation-target` of a reservation command, selecting whether the
+// server configuration, the hosts database or both are used. One of OperationTargets. An empty
+// target leaves the argument unset, so the command works on releases before Kea 2.4.
+func WithOperationTarget(target string) ReservationOption {
+	return func(args map[string]any) {
+		if target != "" {
+			args["operation-target"] = target
+		}
+	}
+}
+
+// applyReservationOptions : Applies the reservation options to the command arguments.
+func applyReservationOptions(args map[string]any, opts []ReservationOption) {
+	for _, opt := range opts {
+		opt(args)
+	}
+}
+
+// Identifier : Returns the identifier type and identifier of the reservation. Kea requires a
+// reservation to carry exactly one identifier, so an error is returned for zero or several.
+func (r Reservation) Identifier() (string, string, error) {
+	var identifierType, identifier string
+	for t, v := range map[string]string{
+		IdentifierHwAddress: r.HwAddress,
+		IdentifierClientID:  r.ClientID,
+		IdentifierDUID:      r.DuID,
+		IdentifierCircuitID: r.CircuitID,
+		IdentifierFlexID:    r.FlexID,
+	} {
+		if v == "" {
+			continue
+		}
+		if identifierType != "" {
+			return "", "", fmt.Errorf("%w: only one of %s may be set", ErrInvalidIdentifier, strings.Join(IdentifierTypes, ", "))
+		}
+		identifierType, identifier = t, v
+	}
+	if identifierType == "" {
+		return "", "", fmt.Errorf("%w: one of %s must be set", ErrInvalidIdentifier, strings.Join(IdentifierTypes, ", "))
+	}
+	return identifierType, identifier, nil
+}
+
+// validate : Checks the reservation before sending it to Kea, normalizing the hw-address.
+func (r *Reservation) validate() error {
+	if net.ParseIP(r.IPAddress) == nil {
+		return ErrInvalidIP
+	}
+	if r.SubnetID < GlobalSubnetID || r.SubnetID > MaxSubnetID {
+		return ErrInvalidSubnet
+	}
+	identifierType, _, err := r.Identifier()
+	if err != nil {
+		return err
+	}
+	if identifierType == IdentifierHwAddress {
+		mac, err := net.ParseMAC(r.HwAddress)
+		if err != nil {
+			return ErrInvalidMAC
+		}
+		r.HwAddress = mac.String()
+	}
+	return nil
+}
+
+// identifierArguments : Returns the arguments that address a single reservation in a subnet,
+// either by `identifier-type`+`identifier` or by `ip-address`.
+func identifierArguments(identifierType, identifier string, subnetID int) (map[string]any, error) {
+	args := map[string]any{"subnet-id": subnetID}
+	switch identifierType {
+	case IdentifierIPAddress:
+		ip := net.ParseIP(identifier)
+		if ip == nil {
+			return nil, ErrInvalidIP
+		}
+		args["ip-address"] = ip.String()
+	case IdentifierHwAddress:
+		mac, err := net.ParseMAC(identifier)
+		if err != nil {
+			return nil, ErrInvalidMAC
+		}
+		args["identifier-type"] = identifierType
+		args["identifier"] = mac.String()
+	case IdentifierClientID, IdentifierDUID, IdentifierCircuitID, IdentifierFlexID:
+		if identifier == "" {
+			return nil, fmt.Errorf("%w: empty %s", ErrInvalidIdentifier, identifierType)
+		}
+		args["identifier-type"] = identifierType
+		args["identifier"] = identifier
+	default:
+		return nil, fmt.Errorf("%w: unknown identifier type `%s`", ErrInvalidIdentifier, identifierType)
+	}
+	return args, nil
+}
+
 // ReservationGetAll : Gets the remote option for the subnet4 list.
-func (c *Client) ReservationGetAll(hostname string, subnetID int) ([]Reservation, error) {
+func (c *Client) ReservationGetAll(hostname string, subnetID int, opts ...ReservationOption) ([]Reservation, error) {
 	payload := Request{
 		Command:   "reservation-get-all",
 		Service:   []string{"dhcp4"},
 		Arguments: map[string]any{"subnet-id": subnetID},
 	}
+	applyReservationOptions(payload.Arguments, opts)
 
 	req, err := c.make(http.MethodPost, hostname, payload, nil)
 	if err != nil {
@@ -46,18 +199,147 @@ func (c *Client) ReservationGetAll(hostname string, subnetID int) ([]Reservation
 	return ret.Hosts, nil
 }
 
-// ReservationGet : Gets a single reservation for the subnet4 list.
-func (c *Client) ReservationGet(hostname, ipOrMac string, subnetID int) (*Reservation, error) {
+// ReservationGetPage : Gets a single page of at most `limit` reservations from the subnet. Pass a nil
+// `from` for the first page and the Next cursor of the previous page for the following pages; the
+// last page is reached when the returned page holds no hosts.
+//
+// POST / {"command":"reservation-get-page","service":["dhcp4"],"arguments":{"subnet-id":1,"limit":100}}
+func (c *Client) ReservationGetPage(hostname string, subnetID, limit int, from *ReservationPageCursor) (*ReservationPage, error) {
 	payload := Request{
-		Command:   "reservation-get",
+		Command:   "reservation-get-page",
 		Service:   []string{"dhcp4"},
-		Arguments: map[string]any{"subnet-id": subnetID},
+		Arguments: map[string]any{"subnet-id": subnetID, "limit": limit},
 	}
-	if ip := net.ParseIP(ipOrMac); ip != nil {
-		payload.Arguments["ip-address"] = ip.String()
-	} else if mac, err := net.ParseMAC(ipOrMac); err == nil {
-		payload.Arguments["identifier-type"] = "hw-address"
-		payload.Arguments["identifier"] = mac.String()
+	if from != nil {
+		payload.Arguments["from"] = from.From
+		payload.Arguments["source-index"] = from.SourceIndex
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := new(ReservationPage)
+	if _, err := c.do(req, ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return &ReservationPage{}, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ReservationList : Gets every reservation in the subnet, fetching `pageSize` reservations at a time
+// with reservation-get-page, so that subnets with many reservations can be listed.
+func (c *Client) ReservationList(hostname string, subnetID, pageSize int) ([]Reservation, error) {
+	ret := make([]Reservation, 0)
+
+	var from *ReservationPageCursor
+	for {
+		page, err := c.ReservationGetPage(hostname, subnetID, pageSize, from)
+		if err != nil {
+			return nil, err
+		}
+		if len(page.Hosts) == 0 || page.Next == nil {
+			return append(ret, page.Hosts...), nil
+		}
+		ret = append(ret, page.Hosts...)
+		from = page.Next
+	}
+}
+
+// ReservationGetByHostname : Gets the reservations with the given hostname. A subnetID of -1 searches
+// every subnet.
+//
+// POST / {"command":"reservation-get-by-hostname","service":["dhcp4"],"arguments":{"hostname":"foo.example.com"}}
+func (c *Client) ReservationGetByHostname(hostname, reservationHostname string, subnetID int, opts ...ReservationOption) ([]Reservation, error) {
+	payload := Request{
+		Command:   "reservation-get-by-hostname",
+		Service:   []string{"dhcp4"},
+		Arguments: map[string]any{"hostname": reservationHostname},
+	}
+	if subnetID >= 0 {
+		payload.Arguments["subnet-id"] = subnetID
+	}
+	applyReservationOptions(payload.Arguments, opts)
+	return c.reservationSearch(hostname, payload)
+}
+
+// ReservationGetByID : Gets the reservations with the given identifier, in every subnet.
+//
+// POST / {"command":"reservation-get-by-id","service":["dhcp4"],"arguments":{"identifier-type":"hw-address","identifier":"aa:bb:cc:dd:ee:ff"}}
+func (c *Client) ReservationGetByID(hostname, identifierType, identifier string, opts ...ReservationOption) ([]Reservation, error) {
+	if identifierType == IdentifierIPAddress {
+		return nil, fmt.Errorf("%w: reservation-get-by-id does not support `%s`", ErrInvalidIdentifier, identifierType)
+	}
+	args, err := identifierArguments(identifierType, identifier, 0)
+	if err != nil {
+		return nil, err
+	}
+	delete(args, "subnet-id")
+	applyReservationOptions(args, opts)
+
+	return c.reservationSearch(hostname, Request{
+		Command:   "reservation-get-by-id",
+		Service:   []string{"dhcp4"},
+		Arguments: args,
+	})
+}
+
+// ReservationGetByAddress : Gets the reservations of the given IP address. A subnetID of -1 searches
+// every subnet.
+//
+// POST / {"command":"reservation-get-by-address","service":["dhcp4"],"arguments":{"ip-address":"192.0.2.10"}}
+func (c *Client) ReservationGetByAddress(hostname, ipAddress string, subnetID int, opts ...ReservationOption) ([]Reservation, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return nil, ErrInvalidIP
+	}
+
+	payload := Request{
+		Command:   "reservation-get-by-address",
+		Service:   []string{"dhcp4"},
+		Arguments: map[string]any{"ip-address": ip.String()},
+	}
+	if subnetID >= 0 {
+		payload.Arguments["subnet-id"] = subnetID
+	}
+	applyReservationOptions(payload.Arguments, opts)
+	return c.reservationSearch(hostname, payload)
+}
+
+// reservationSearch : Sends a host_cmds search command, returning an empty list when nothing matches.
+func (c *Client) reservationSearch(hostname string, payload Request) ([]Reservation, error) {
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Hosts []Reservation `json:"hosts"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return make([]Reservation, 0), nil
+		}
+		return nil, err
+	}
+	return ret.Hosts, nil
+}
+
+// ReservationGet : Gets a single reservation for the subnet4 list, addressed by one of the
+// IdentifierTypes and its identifier, or by IdentifierIPAddress and the reserved address.
+func (c *Client) ReservationGet(hostname, identifierType, identifier string, subnetID int, opts ...ReservationOption) (*Reservation, error) {
+	args, err := identifierArguments(identifierType, identifier, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	applyReservationOptions(args, opts)
+	payload := Request{
+		Command:   "reservation-get",
+		Service:   []string{"dhcp4"},
+		Arguments: args,
 	}
 
 	req, err := c.make(http.MethodPost, hostname, payload, nil)
@@ -66,7 +348,7 @@ func (c *Client) ReservationGet(hostname, ipOrMac string, subnetID int) (*Reserv
 	}
 	ret := new(Reservation)
 	if _, err := c.do(req, ret); err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, nil
 		}
 		return nil, err
@@ -75,14 +357,9 @@ func (c *Client) ReservationGet(hostname, ipOrMac string, subnetID int) (*Reserv
 }
 
 // ReservationAdd : Adds a reservation to the subnet4 list.
-func (c *Client) ReservationAdd(hostname string, res Reservation) error {
-	if net.ParseIP(res.IPAddress) == nil {
-		return ErrInvalidIP
-	}
-	if val, err := net.ParseMAC(res.HwAddress); err == nil {
-		res.HwAddress = val.String()
-	} else {
-		return ErrInvalidMAC
+func (c *Client) ReservationAdd(hostname string, res Reservation, opts ...ReservationOption) error {
+	if err := res.validate(); err != nil {
+		return err
 	}
 
 	payload := Request{
@@ -91,6 +368,8 @@ func (c *Client) ReservationAdd(hostname string, res Reservation) error {
 		Arguments: map[string]any{"reservation": res},
 	}
 
+	applyReservationOptions(payload.Arguments, opts)
+
 	req, err := c.make(http.MethodPost, hostname, payload, nil)
 	if err != nil {
 		return err
@@ -106,17 +385,9 @@ func (c *Client) ReservationAdd(hostname string, res Reservation) error {
 }
 
 // ReservationUpdate : Updates a reservation to the subnet4 list.
-func (c *Client) ReservationUpdate(hostname string, res Reservation) error {
-	if net.ParseIP(res.IPAddress) == nil {
-		return ErrInvalidIP
-	}
-	if val, err := net.ParseMAC(res.HwAddress); err == nil {
-		res.HwAddress = val.String()
-	} else {
-		return ErrInvalidMAC
-	}
-	if res.SubnetID == 0 {
-		return ErrInvalidSubnet
+func (c *Client) ReservationUpdate(hostname string, res Reservation, opts ...ReservationOption) error {
+	if err := res.validate(); err != nil {
+		return err
 	}
 
 	payload := Request{
@@ -125,6 +396,8 @@ func (c *Client) ReservationUpdate(hostname string, res Reservation) error {
 		Arguments: map[string]any{"reservation": res},
 	}
 
+	applyReservationOptions(payload.Arguments, opts)
+
 	req, err := c.make(http.MethodPost, hostname, payload, nil)
 	if err != nil {
 		return err
@@ -139,16 +412,19 @@ func (c *Client) ReservationUpdate(hostname string, res Reservation) error {
 	return nil
 }
 
-// ReservationDel : Deletes a reservation to the subnet4 list.
-func (c *Client) ReservationDel(hostname, ipAddress string, subnetID int) error {
-	if net.ParseIP(ipAddress) == nil {
-		return ErrInvalidIP
+// ReservationDel : Deletes a reservation from the subnet4 list, addressed by one of the
+// IdentifierTypes and its identifier, or by IdentifierIPAddress and the reserved address.
+func (c *Client) ReservationDel(hostname, identifierType, identifier string, subnetID int, opts ...ReservationOption) error {
+	args, err := identifierArguments(identifierType, identifier, subnetID)
+	if err != nil {
+		return err
 	}
+	applyReservationOptions(args, opts)
 
 	payload := Request{
 		Command:   "reservation-del",
 		Service:   []string{"dhcp4"},
-		Arguments: map[string]any{"subnet-id": subnetID, "ip-address": ipAddress},
+		Arguments: args,
 	}
 
 	req, err := c.make(http.MethodPost, hostname, payload, nil)
@@ -0,0 +1,217 @@
+package kea
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// DHCP6OptionSpace : Option space of the standard DHCPv6 options, the default space of dhcp6 option-data.
+const DHCP6OptionSpace = "dhcp6"
+
+// IdentifierTypes6 : Every host identifier type supported by Kea DHCPv6 reservations.
+var IdentifierTypes6 = []string{IdentifierDUID, IdentifierHwAddress, IdentifierFlexID}
+
+type (
+	// Reservation6 : Represents a single DHCPv6 reservation entry in Kea.
+	Reservation6 struct {
+		ClientClasses []string       `json:"client-classes,omitempty"`
+		DuID          string         `json:"duid,omitempty"`
+		HwAddress     string         `json:"hw-address,omitempty"`
+		FlexID        string         `json:"flex-id,omitempty"`
+		Hostname      string         `json:"hostname,omitempty"`
+		IPAddresses   []string       `json:"ip-addresses,omitempty"`
+		Prefixes      []string       `json:"prefixes,omitempty"`
+		OptionData    []OptionData   `json:"option-data,omitempty"`
+		SubnetID      int            `json:"subnet-id"`
+		UserContext   map[string]any `json:"user-context,omitempty"`
+	}
+)
+
+// Identifier : Returns the identifier type and identifier of the reservation. Kea requires a
+// reservation to carry exactly one identifier, so an error is returned for zero or several.
+func (r Reservation6) Identifier() (string, string, error) {
+	var identifierType, identifier string
+	for t, v := range map[string]string{
+		IdentifierDUID:      r.DuID,
+		IdentifierHwAddress: r.HwAddress,
+		IdentifierFlexID:    r.FlexID,
+	} {
+		if v == "" {
+			continue
+		}
+		if identifierType != "" {
+			return "", "", fmt.Errorf("%w: only one of %s may be set", ErrInvalidIdentifier, strings.Join(IdentifierTypes6, ", "))
+		}
+		identifierType, identifier = t, v
+	}
+	if identifierType == "" {
+		return "", "", fmt.Errorf("%w: one of %s must be set", ErrInvalidIdentifier, strings.Join(IdentifierTypes6, ", "))
+	}
+	return identifierType, identifier, nil
+}
+
+// validate : Checks the reservation before sending it to Kea, normalizing the hw-address.
+func (r *Reservation6) validate() error {
+	if r.SubnetID < GlobalSubnetID || r.SubnetID > MaxSubnetID {
+		return ErrInvalidSubnet
+	}
+	for _, a := range r.IPAddresses {
+		if ip := net.ParseIP(a); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%w: `%s` is not an IPv6 address", ErrInvalidIP, a)
+		}
+	}
+	for _, p := range r.Prefixes {
+		if ip, _, err := net.ParseCIDR(p); err != nil || ip.To4() != nil {
+			return fmt.Errorf("%w: `%s` is not an IPv6 prefix", ErrInvalidIP, p)
+		}
+	}
+	identifierType, _, err := r.Identifier()
+	if err != nil {
+		return err
+	}
+	if identifierType == IdentifierHwAddress {
+		mac, err := net.ParseMAC(r.HwAddress)
+		if err != nil {
+			return ErrInvalidMAC
+		}
+		r.HwAddress = mac.String()
+	}
+	return nil
+}
+
+// Reservation6GetAll : Gets every DHCPv6 reservation in the subnet6.
+func (c *Client) Reservation6GetAll(hostname string, subnetID int, opts ...ReservationOption) ([]Reservation6, error) {
+	payload := Request{
+		Command:   "reservation-get-all",
+		Service:   []string{"dhcp6"},
+		Arguments: map[string]any{"subnet-id": subnetID},
+	}
+	applyReservationOptions(payload.Arguments, opts)
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret struct {
+		Hosts []Reservation6 `json:"hosts"`
+	}
+	if _, err := c.do(req, &ret); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return make([]Reservation6, 0), nil
+		}
+		return nil, err
+	}
+	return ret.Hosts, nil
+}
+
+// Reservation6Get : Gets a single DHCPv6 reservation in the subnet6, addressed by one of the
+// IdentifierTypes6 and its identifier, or by IdentifierIPAddress and a reserved address.
+func (c *Client) Reservation6Get(hostname, identifierType, identifier string, subnetID int, opts ...ReservationOption) (*Reservation6, error) {
+	args, err := identifierArguments(identifierType, identifier, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	applyReservationOptions(args, opts)
+
+	payload := Request{
+		Command:   "reservation-get",
+		Service:   []string{"dhcp6"},
+		Arguments: args,
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := new(Reservation6)
+	if _, err := c.do(req, ret); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Reservation6Add : Adds a DHCPv6 reservation to the subnet6.
+func (c *Client) Reservation6Add(hostname string, res Reservation6, opts ...ReservationOption) error {
+	if err := res.validate(); err != nil {
+		return err
+	}
+
+	payload := Request{
+		Command:   "reservation-add",
+		Service:   []string{"dhcp6"},
+		Arguments: map[string]any{"reservation": res},
+	}
+
+	applyReservationOptions(payload.Arguments, opts)
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Reservation6Update : Updates a DHCPv6 reservation in the subnet6.
+func (c *Client) Reservation6Update(hostname string, res Reservation6, opts ...ReservationOption) error {
+	if err := res.validate(); err != nil {
+		return err
+	}
+
+	payload := Request{
+		Command:   "reservation-update",
+		Service:   []string{"dhcp6"},
+		Arguments: map[string]any{"reservation": res},
+	}
+
+	applyReservationOptions(payload.Arguments, opts)
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Reservation6Del : Deletes a DHCPv6 reservation from the subnet6, addressed by one of the
+// IdentifierTypes6 and its identifier, or by IdentifierIPAddress and a reserved address.
+func (c *Client) Reservation6Del(hostname, identifierType, identifier string, subnetID int, opts ...ReservationOption) error {
+	args, err := identifierArguments(identifierType, identifier, subnetID)
+	if err != nil {
+		return err
+	}
+	applyReservationOptions(args, opts)
+
+	payload := Request{
+		Command:   "reservation-del",
+		Service:   []string{"dhcp6"},
+		Arguments: args,
+	}
+
+	req, err := c.make(http.MethodPost, hostname, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	var ret interface{}
+	if _, err := c.do(req, &ret); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package kea
+
+// VendorEncapsulatedOptionsSpace : Option space of the sub-options carried by option 43, vendor-encapsulated-options.
+const VendorEncapsulatedOptionsSpace = "vendor-encapsulated-options-space"
+
+// StandardOptionDefs4 : Definitions of the standard DHCPv4 options that Kea knows without an option-def,
+// as listed in the "List of Standard DHCPv4 Options" of the Kea Administrator Reference Manual.
+var StandardOptionDefs4 = []RemoteOptionDef4{
+	{Code: 1, Name: "subnet-mask", Type: "ipv4-address"},
+	{Code: 2, Name: "time-offset", Type: "int32"},
+	{Code: 3, Name: "routers", Type: "ipv4-address", Array: true},
+	{Code: 4, Name: "time-servers", Type: "ipv4-address", Array: true},
+	{Code: 5, Name: "name-servers", Type: "ipv4-address", Array: true},
+	{Code: 6, Name: "domain-name-servers", Type: "ipv4-address", Array: true},
+	{Code: 7, Name: "log-servers", Type: "ipv4-address", Array: true},
+	{Code: 8, Name: "cookie-servers", Type: "ipv4-address", Array: true},
+	{Code: 9, Name: "lpr-servers", Type: "ipv4-address", Array: true},
+	{Code: 10, Name: "impress-servers", Type: "ipv4-address", Array: true},
+	{Code: 11, Name: "resource-location-servers", Type: "ipv4-address", Array: true},
+	{Code: 12, Name: "host-name", Type: "string"},
+	{Code: 13, Name: "boot-size", Type: "uint16"},
+	{Code: 14, Name: "merit-dump", Type: "string"},
+	{Code: 15, Name: "domain-name", Type: "fqdn"},
+	{Code: 16, Name: "swap-server", Type: "ipv4-address"},
+	{Code: 17, Name: "root-path", Type: "string"},
+	{Code: 18, Name: "extensions-path", Type: "string"},
+	{Code: 19, Name: "ip-forwarding", Type: "boolean"},
+	{Code: 20, Name: "non-local-source-routing", Type: "boolean"},
+	{Code: 21, Name: "policy-filter", Type: "ipv4-address", Array: true},
+	{Code: 22, Name: "max-dgram-reassembly", Type: "uint16"},
+	{Code: 23, Name: "default-ip-ttl", Type: "uint8"},
+	{Code: 24, Name: "path-mtu-aging-timeout", Type: "uint32"},
+	{Code: 25, Name: "path-mtu-plateau-table", Type: "uint16", Array: true},
+	{Code: 26, Name: "interface-mtu", Type: "uint16"},
+	{Code: 27, Name: "all-subnets-local", Type: "boolean"},
+	{Code: 28, Name: "broadcast-address", Type: "ipv4-address"},
+	{Code: 29, Name: "perform-mask-discovery", Type: "boolean"},
+	{Code: 30, Name: "mask-supplier", Type: "boolean"},
+	{Code: 31, Name: "router-discovery", Type: "boolean"},
+	{Code: 32, Name: "router-solicitation-address", Type: "ipv4-address"},
+	{Code: 33, Name: "static-routes", Type: "ipv4-address", Array: true},
+	{Code: 34, Name: "trailer-encapsulation", Type: "boolean"},
+	{Code: 35, Name: "arp-cache-timeout", Type: "uint32"},
+	{Code: 36, Name: "ieee802-3-encapsulation", Type: "boolean"},
+	{Code: 37, Name: "default-tcp-ttl", Type: "uint8"},
+	{Code: 38, Name: "tcp-keepalive-interval", Type: "uint32"},
+	{Code: 39, Name: "tcp-keepalive-garbage", Type: "boolean"},
+	{Code: 40, Name: "nis-domain", Type: "string"},
+	{Code: 41, Name: "nis-servers", Type: "ipv4-address", Array: true},
+	{Code: 42, Name: "ntp-servers", Type: "ipv4-address", Array: true},
+	{Code: 43, Name: "vendor-encapsulated-options", Type: "empty", Encapsulate: VendorEncapsulatedOptionsSpace},
+	{Code: 44, Name: "netbios-name-servers", Type: "ipv4-address", Array: true},
+	{Code: 45, Name: "netbios-dd-server", Type: "ipv4-address", Array: true},
+	{Code: 46, Name: "netbios-node-type", Type: "uint8"},
+	{Code: 47, Name: "netbios-scope", Type: "string"},
+	{Code: 48, Name: "font-servers", Type: "ipv4-address", Array: true},
+	{Code: 49, Name: "x-display-manager", Type: "ipv4-address", Array: true},
+	{Code: 50, Name: "dhcp-requested-address", Type: "ipv4-address"},
+	{Code: 51, Name: "dhcp-lease-time", Type: "uint32"},
+	{Code: 52, Name: "dhcp-option-overload", Type: "uint8"},
+	{Code: 53, Name: "dhcp-message-type", Type: "uint8"},
+	{Code: 54, Name: "dhcp-server-identifier", Type: "ipv4-address"},
+	{Code: 55, Name: "dhcp-parameter-request-list", Type: "uint8", Array: true},
+	{Code: 56, Name: "dhcp-message", Type: "string"},
+	{Code: 57, Name: "dhcp-max-message-size", Type: "uint16"},
+	{Code: 58, Name: "dhcp-renewal-time", Type: "uint32"},
+	{Code: 59, Name: "dhcp-rebinding-time", Type: "uint32"},
+	{Code: 60, Name: "vendor-class-identifier", Type: "string"},
+	{Code: 61, Name: "dhcp-client-identifier", Type: "binary"},
+	{Code: 62, Name: "nwip-domain-name", Type: "string"},
+	{Code: 63, Name: "nwip-suboptions", Type: "binary"},
+	{Code: 64, Name: "nisplus-domain-name", Type: "string"},
+	{Code: 65, Name: "nisplus-servers", Type: "ipv4-address", Array: true},
+	{Code: 66, Name: "tftp-server-name", Type: "string"},
+	{Code: 67, Name: "boot-file-name", Type: "string"},
+	{Code: 68, Name: "mobile-ip-home-agent", Type: "ipv4-address", Array: true},
+	{Code: 69, Name: "smtp-server", Type: "ipv4-address", Array: true},
+	{Code: 70, Name: "pop-server", Type: "ipv4-address", Array: true},
+	{Code: 71, Name: "nntp-server", Type: "ipv4-address", Array: true},
+	{Code: 72, Name: "www-server", Type: "ipv4-address", Array: true},
+	{Code: 73, Name: "finger-server", Type: "ipv4-address", Array: true},
+	{Code: 74, Name: "irc-server", Type: "ipv4-address", Array: true},
+	{Code: 75, Name: "streettalk-server", Type: "ipv4-address", Array: true},
+	{Code: 76, Name: "streettalk-directory-assistance-server", Type: "ipv4-address", Array: true},
+	{Code: 77, Name: "user-class", Type: "binary"},
+	{Code: 78, Name: "slp-directory-agent", Type: "record", Array: true, RecordTypes: "boolean, ipv4-address"},
+	{Code: 79, Name: "slp-service-scope", Type: "record", RecordTypes: "boolean, string"},
+	{Code: 81, Name: "fqdn", Type: "record", RecordTypes: "uint8, uint8, uint8, fqdn"},
+	{Code: 82, Name: "dhcp-agent-options", Type: "empty", Encapsulate: "dhcp-agent-options-space"},
+	{Code: 85, Name: "nds-servers", Type: "ipv4-address", Array: true},
+	{Code: 86, Name: "nds-tree-name", Type: "string"},
+	{Code: 87, Name: "nds-context", Type: "string"},
+	{Code: 88, Name: "bcms-controller-names", Type: "fqdn", Array: true},
+	{Code: 89, Name: "bcms-controller-address", Type: "ipv4-address", Array: true},
+	{Code: 90, Name: "authenticate", Type: "binary"},
+	{Code: 91, Name: "client-last-transaction-time", Type: "uint32"},
+	{Code: 92, Name: "associated-ip", Type: "ipv4-address", Array: true},
+	{Code: 93, Name: "client-system", Type: "uint16", Array: true},
+	{Code: 94, Name: "client-ndi", Type: "record", RecordTypes: "uint8, uint8, uint8"},
+	{Code: 97, Name: "uuid-guid", Type: "record", RecordTypes: "uint8, binary"},
+	{Code: 98, Name: "uap-servers", Type: "string"},
+	{Code: 99, Name: "geoconf-civic", Type: "binary"},
+	{Code: 100, Name: "pcode", Type: "string"},
+	{Code: 101, Name: "tcode", Type: "string"},
+	{Code: 108, Name: "v6-only-preferred", Type: "uint32"},
+	{Code: 112, Name: "netinfo-server-address", Type: "ipv4-address", Array: true},
+	{Code: 113, Name: "netinfo-server-tag", Type: "string"},
+	{Code: 114, Name: "v4-captive-portal", Type: "string"},
+	{Code: 116, Name: "auto-config", Type: "uint8"},
+	{Code: 117, Name: "name-service-search", Type: "uint16", Array: true},
+	{Code: 118, Name: "subnet-selection", Type: "ipv4-address"},
+	{Code: 119, Name: "domain-search", Type: "fqdn", Array: true},
+	{Code: 121, Name: "classless-static-route", Type: "internal"},
+	{Code: 124, Name: "vivco-suboptions", Type: "record", RecordTypes: "uint32, binary"},
+	{Code: 125, Name: "vivso-suboptions", Type: "uint32"},
+	{Code: 136, Name: "pana-agent", Type: "ipv4-address", Array: true},
+	{Code: 137, Name: "v4-lost", Type: "fqdn"},
+	{Code: 138, Name: "capwap-ac-v4", Type: "ipv4-address", Array: true},
+	{Code: 141, Name: "sip-ua-cs-domains", Type: "fqdn", Array: true},
+	{Code: 146, Name: "rdnss-selection", Type: "record", Array: true, RecordTypes: "uint8, ipv4-address, ipv4-address, fqdn"},
+	{Code: 159, Name: "v4-portparams", Type: "record", RecordTypes: "uint8, psid"},
+	{Code: 212, Name: "option-6rd", Type: "record", Array: true, RecordTypes: "uint8, uint8, ipv6-address, ipv4-address"},
+	{Code: 213, Name: "v4-access-domain", Type: "fqdn"},
+}
+
+// StandardOptionDef4 : Returns the standard DHCPv4 option definition with the given code, or nil.
+func StandardOptionDef4(code int) *RemoteOptionDef4 {
+	for i := range StandardOptionDefs4 {
+		if StandardOptionDefs4[i].Code == code {
+			def := StandardOptionDefs4[i]
+			def.Space = DHCP4OptionSpace
+			return &def
+		}
+	}
+	return nil
+}
+
+// StandardOptionDef4ByName : Returns the standard DHCPv4 option definition with the given name, or nil.
+func StandardOptionDef4ByName(name string) *RemoteOptionDef4 {
+	for i := range StandardOptionDefs4 {
+		if StandardOptionDefs4[i].Name == name {
+			def := StandardOptionDefs4[i]
+			def.Space = DHCP4OptionSpace
+			return &def
+		}
+	}
+	return nil
+}
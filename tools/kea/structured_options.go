@@ -0,0 +1,122 @@
+package kea
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// ClasslessStaticRouteCode : Code of option 121, classless-static-route (RFC 3442).
+	ClasslessStaticRouteCode = 121
+	// VendorEncapsulatedOptionsCode : Code of option 43, vendor-encapsulated-options.
+	VendorEncapsulatedOptionsCode = 43
+)
+
+type (
+	// Route : A classless static route of option 121, a destination prefix reached through a gateway.
+	Route struct {
+		Destination string
+		Gateway     string
+	}
+
+	// VendorSubOption : A sub-option of option 43, defined in the vendor-encapsulated-options-space
+	// with the given option type.
+	VendorSubOption struct {
+		Code int
+		Name string
+		Type string
+		Data string
+	}
+)
+
+// ClasslessStaticRouteOption : Encodes routes as the option-data of option 121. The data is written in
+// the RFC 3442 wire format, as hexadecimal, so that every Kea version accepts it.
+func ClasslessStaticRouteOption(routes []Route) (OptionData, error) {
+	var b []byte
+	for _, r := range routes {
+		_, network, err := net.ParseCIDR(r.Destination)
+		if err != nil || network.IP.To4() == nil {
+			return OptionData{}, fmt.Errorf("route destination `%s` is not an IPv4 prefix", r.Destination)
+		}
+		gateway := net.ParseIP(r.Gateway).To4()
+		if gateway == nil {
+			return OptionData{}, fmt.Errorf("route gateway `%s` is not an IPv4 address", r.Gateway)
+		}
+		ones, _ := network.Mask.Size()
+		b = append(b, byte(ones))
+		b = append(b, network.IP.To4()[:(ones+7)/8]...)
+		b = append(b, gateway...)
+	}
+
+	code, space, csv := ClasslessStaticRouteCode, DHCP4OptionSpace, false
+	return OptionData{
+		Code:      &code,
+		Name:      "classless-static-route",
+		Space:     &space,
+		Data:      hex.EncodeToString(b),
+		CSVFormat: &csv,
+	}, nil
+}
+
+// ParseClasslessStaticRoutes : Decodes the routes of option 121 option-data, written either in the RFC 3442
+// wire format as hexadecimal, or in Kea's `10.229.0.128/25 - 10.229.0.1, ...` format.
+func ParseClasslessStaticRoutes(o OptionData) ([]Route, error) {
+	if o.CSVFormat == nil || *o.CSVFormat {
+		if strings.Contains(o.Data, "-") || strings.Contains(o.Data, "/") {
+			return parseRouteList(o.Data)
+		}
+	}
+
+	s := strings.NewReplacer(":", "", " ", "").Replace(strings.TrimPrefix(o.Data, "0x"))
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("option 121 data `%s` is not hexadecimal", o.Data)
+	}
+
+	routes := make([]Route, 0)
+	for len(b) > 0 {
+		ones := int(b[0])
+		octets := (ones + 7) / 8
+		if ones > 32 || len(b) < 1+octets+4 {
+			return nil, fmt.Errorf("option 121 data `%s` is truncated", o.Data)
+		}
+		destination := make(net.IP, 4)
+		copy(destination, b[1:1+octets])
+		gateway := net.IP(b[1+octets : 1+octets+4])
+		routes = append(routes, Route{
+			Destination: fmt.Sprintf("%s/%d", destination, ones),
+			Gateway:     gateway.String(),
+		})
+		b = b[1+octets+4:]
+	}
+	return routes, nil
+}
+
+// parseRouteList : Parses Kea's `destination - gateway` list of option 121 routes.
+func parseRouteList(data string) ([]Route, error) {
+	routes := make([]Route, 0)
+	for _, v := range strings.Split(data, ",") {
+		destination, gateway, ok := strings.Cut(v, "-")
+		if !ok {
+			return nil, fmt.Errorf("option 121 route `%s` is not `destination - gateway`", strings.TrimSpace(v))
+		}
+		routes = append(routes, Route{Destination: strings.TrimSpace(destination), Gateway: strings.TrimSpace(gateway)})
+	}
+	return routes, nil
+}
+
+// VendorEncapsulatedOptions : Returns the option-data of option 43 carrying the sub-options, and the
+// option-defs that Kea needs to encode the sub-options in the vendor-encapsulated-options-space.
+func VendorEncapsulatedOptions(subs []VendorSubOption) ([]OptionData, []RemoteOptionDef4) {
+	code, space := VendorEncapsulatedOptionsCode, DHCP4OptionSpace
+	opts := []OptionData{{Code: &code, Name: "vendor-encapsulated-options", Space: &space}}
+	defs := make([]RemoteOptionDef4, 0, len(subs))
+	for _, s := range subs {
+		code, space := s.Code, VendorEncapsulatedOptionsSpace
+		opts = append(opts, OptionData{Code: &code, Name: s.Name, Space: &space, Data: s.Data})
+		defs = append(defs, RemoteOptionDef4{Code: s.Code, Name: s.Name, Type: s.Type, Space: VendorEncapsulatedOptionsSpace})
+	}
+	return opts, defs
+}